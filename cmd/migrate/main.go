@@ -0,0 +1,211 @@
+// migrate 管理各服务的数据库迁移。
+//
+//	go run ./cmd/migrate -service user-service up
+//	go run ./cmd/migrate -service user-service down [N]
+//	go run ./cmd/migrate -service user-service status
+//	go run ./cmd/migrate -service user-service baseline 1
+//	go run ./cmd/migrate -service user-service force 3
+//	go run ./cmd/migrate -service user-service create add_user_phone
+//
+// 数据库连接与服务相同，读取 CONFIG_FILE 与 DB_* 环境变量。
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"live-stream-platform/pkg/config"
+	"live-stream-platform/pkg/database"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/pkg/migrate"
+	adminMigrations "live-stream-platform/services/admin-service/migrations"
+	giftMigrations "live-stream-platform/services/gift-service/migrations"
+	roomMigrations "live-stream-platform/services/room-service/migrations"
+	userMigrations "live-stream-platform/services/user-service/migrations"
+)
+
+// sources 各服务编译进二进制的迁移文件
+var sources = map[string]fs.FS{
+	"user-service":  userMigrations.FS,
+	"room-service":  roomMigrations.FS,
+	"gift-service":  giftMigrations.FS,
+	"admin-service": adminMigrations.FS,
+}
+
+func main() {
+	service := flag.String("service", "", "service name: "+strings.Join(serviceNames(), ", "))
+	dir := flag.String("dir", "", "migrations directory for create (default services/<service>/migrations)")
+	flag.Usage = usage
+	flag.Parse()
+
+	fsys, ok := sources[*service]
+	if !ok || flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+
+	if err := run(*service, fsys, *dir, flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(service string, fsys fs.FS, dir string, args []string) error {
+	cmd, args := args[0], args[1:]
+	if cmd == "create" {
+		if len(args) != 1 {
+			return fmt.Errorf("usage: migrate -service %s create <name>", service)
+		}
+		if dir == "" {
+			dir = filepath.Join("services", service, "migrations")
+		}
+		up, down, err := migrate.Create(dir, args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Println("Created", up)
+		fmt.Println("Created", down)
+		return nil
+	}
+
+	m, err := open(service, fsys)
+	if err != nil {
+		return err
+	}
+	defer database.Close()
+	ctx := context.Background()
+
+	switch cmd {
+	case "up":
+		applied, err := m.Up(ctx)
+		for _, mig := range applied {
+			fmt.Printf("Applied %04d_%s\n", mig.Version, mig.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("No pending migrations")
+		}
+		return err
+	case "down":
+		steps := 1
+		if len(args) > 0 {
+			if steps, err = strconv.Atoi(args[0]); err != nil {
+				return fmt.Errorf("invalid step count %q", args[0])
+			}
+		}
+		reverted, err := m.Down(ctx, steps)
+		for _, mig := range reverted {
+			fmt.Printf("Reverted %04d_%s\n", mig.Version, mig.Name)
+		}
+		if err == nil && len(reverted) == 0 {
+			fmt.Println("No applied migrations")
+		}
+		return err
+	case "baseline", "force":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: migrate -service %s %s <version>", service, cmd)
+		}
+		version, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version %q", args[0])
+		}
+		if cmd == "force" {
+			if err := m.Force(ctx, version); err != nil {
+				return err
+			}
+			fmt.Printf("Forced version %04d\n", version)
+			return nil
+		}
+		recorded, err := m.Baseline(ctx, version)
+		for _, mig := range recorded {
+			fmt.Printf("Baselined %04d_%s\n", mig.Version, mig.Name)
+		}
+		if err == nil && len(recorded) == 0 {
+			fmt.Println("Nothing to baseline")
+		}
+		return err
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		printStatus(statuses)
+		return nil
+	default:
+		return fmt.Errorf("unknown command %q", cmd)
+	}
+}
+
+// open 连接数据库，日志只输出警告以上，避免干扰命令输出
+func open(service string, fsys fs.FS) (*migrate.Migrator, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+	cfg.Log.Level = "warn"
+	logger.Init("migrate", &cfg.Log)
+	if err := database.Init(&cfg.Database); err != nil {
+		return nil, err
+	}
+	sqlDB, err := database.DB.DB()
+	if err != nil {
+		return nil, err
+	}
+	return migrate.New(sqlDB, service, fsys)
+}
+
+func printStatus(statuses []migrate.Status) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+	for _, s := range statuses {
+		state, appliedAt := "pending", "-"
+		if s.Applied {
+			state = "applied"
+			appliedAt = s.AppliedAt.Format(time.DateTime)
+		}
+		switch {
+		case s.Dirty:
+			state = "dirty"
+		case s.Modified:
+			state = "modified"
+		case s.Missing:
+			state = "missing file"
+		}
+		fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", s.Version, s.Name, state, appliedAt)
+	}
+	w.Flush()
+}
+
+func serviceNames() []string {
+	names := make([]string, 0, len(sources))
+	for name := range sources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, `Usage: migrate -service <name> <command> [args]
+
+Commands:
+  up             apply all pending migrations
+  down [N]       revert the last N migrations (default 1)
+  status         list migrations and whether they are applied
+  baseline <V>   mark migrations up to version V as applied without running them,
+                 for databases created before migrations were tracked
+  force <V>      mark version V as applied and clear its dirty flag after a manual fix
+  create <name>  write empty up/down files for the next version
+
+Flags:
+`)
+	flag.PrintDefaults()
+}
@@ -0,0 +1,186 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: common/event.proto
+
+package common
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 领域事件信封，消息体按 AMQP content-type 编码为 protobuf 或 JSON
+type EventEnvelope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`                                                                                          // 全局唯一，消费方据此去重
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                                                                                               // 事件类型，同时作为路由键，如 user.registered
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`                                                                                                        // 负载 schema 版本，不兼容变更时递增
+	OccurredAt    int64                  `protobuf:"varint,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`                                                                                // 发生时间，Unix 毫秒
+	Producer      string                 `protobuf:"bytes,5,opt,name=producer,proto3" json:"producer,omitempty"`                                                                                                       // 产生事件的服务
+	TraceContext  map[string]string      `protobuf:"bytes,6,rep,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 产生事件时的 W3C trace-context
+	Payload       *anypb.Any             `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`                                                                                                         // 负载，消息类型由事件注册表按 type 确定
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventEnvelope) Reset() {
+	*x = EventEnvelope{}
+	mi := &file_common_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventEnvelope) ProtoMessage() {}
+
+func (x *EventEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_common_event_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventEnvelope.ProtoReflect.Descriptor instead.
+func (*EventEnvelope) Descriptor() ([]byte, []int) {
+	return file_common_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventEnvelope) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EventEnvelope) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EventEnvelope) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *EventEnvelope) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+func (x *EventEnvelope) GetProducer() string {
+	if x != nil {
+		return x.Producer
+	}
+	return ""
+}
+
+func (x *EventEnvelope) GetTraceContext() map[string]string {
+	if x != nil {
+		return x.TraceContext
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetPayload() *anypb.Any {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+var File_common_event_proto protoreflect.FileDescriptor
+
+const file_common_event_proto_rawDesc = "" +
+	"\n" +
+	"\x12common/event.proto\x12\x06common\x1a\x19google/protobuf/any.proto\"\xd4\x02\n" +
+	"\rEventEnvelope\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12\x1f\n" +
+	"\voccurred_at\x18\x04 \x01(\x03R\n" +
+	"occurredAt\x12\x1a\n" +
+	"\bproducer\x18\x05 \x01(\tR\bproducer\x12L\n" +
+	"\rtrace_context\x18\x06 \x03(\v2'.common.EventEnvelope.TraceContextEntryR\ftraceContext\x12.\n" +
+	"\apayload\x18\a \x01(\v2\x14.google.protobuf.AnyR\apayload\x1a?\n" +
+	"\x11TraceContextEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B'Z%live-stream-platform/gen/proto/commonb\x06proto3"
+
+var (
+	file_common_event_proto_rawDescOnce sync.Once
+	file_common_event_proto_rawDescData []byte
+)
+
+func file_common_event_proto_rawDescGZIP() []byte {
+	file_common_event_proto_rawDescOnce.Do(func() {
+		file_common_event_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_common_event_proto_rawDesc), len(file_common_event_proto_rawDesc)))
+	})
+	return file_common_event_proto_rawDescData
+}
+
+var file_common_event_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_common_event_proto_goTypes = []any{
+	(*EventEnvelope)(nil), // 0: common.EventEnvelope
+	nil,                   // 1: common.EventEnvelope.TraceContextEntry
+	(*anypb.Any)(nil),     // 2: google.protobuf.Any
+}
+var file_common_event_proto_depIdxs = []int32{
+	1, // 0: common.EventEnvelope.trace_context:type_name -> common.EventEnvelope.TraceContextEntry
+	2, // 1: common.EventEnvelope.payload:type_name -> google.protobuf.Any
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_common_event_proto_init() }
+func file_common_event_proto_init() {
+	if File_common_event_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_event_proto_rawDesc), len(file_common_event_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_common_event_proto_goTypes,
+		DependencyIndexes: file_common_event_proto_depIdxs,
+		MessageInfos:      file_common_event_proto_msgTypes,
+	}.Build()
+	File_common_event_proto = out.File
+	file_common_event_proto_goTypes = nil
+	file_common_event_proto_depIdxs = nil
+}
@@ -0,0 +1,371 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: gift/gift.proto
+
+package gift
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GiftService_ListGifts_FullMethodName         = "/gift.GiftService/ListGifts"
+	GiftService_GetWallet_FullMethodName         = "/gift.GiftService/GetWallet"
+	GiftService_Recharge_FullMethodName          = "/gift.GiftService/Recharge"
+	GiftService_SendGift_FullMethodName          = "/gift.GiftService/SendGift"
+	GiftService_ListLedgerEntries_FullMethodName = "/gift.GiftService/ListLedgerEntries"
+	GiftService_ReconcileWallet_FullMethodName   = "/gift.GiftService/ReconcileWallet"
+	GiftService_Health_FullMethodName            = "/gift.GiftService/Health"
+)
+
+// GiftServiceClient is the client API for GiftService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 业务错误通过 gRPC 状态码返回，ErrorInfo 详情携带错误原因与稳定的数字错误码（metadata.code），
+// 同时附带 common.Response 详情，其 code/message 与迁移前失败响应中的字段相同。
+// 响应中的 code/message 字段仅为兼容保留，成功时为 0/success
+type GiftServiceClient interface {
+	// 获取礼物列表
+	ListGifts(ctx context.Context, in *ListGiftsRequest, opts ...grpc.CallOption) (*ListGiftsResponse, error)
+	// 获取钱包余额
+	GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error)
+	// 充值（由支付回调调用，order_no 保证幂等）
+	Recharge(ctx context.Context, in *RechargeRequest, opts ...grpc.CallOption) (*RechargeResponse, error)
+	// 赠送礼物
+	SendGift(ctx context.Context, in *SendGiftRequest, opts ...grpc.CallOption) (*SendGiftResponse, error)
+	// 分页获取账户流水
+	ListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest, opts ...grpc.CallOption) (*ListLedgerEntriesResponse, error)
+	// 根据流水重算余额并与钱包对账
+	ReconcileWallet(ctx context.Context, in *ReconcileWalletRequest, opts ...grpc.CallOption) (*ReconcileWalletResponse, error)
+	// 健康检查
+	Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
+}
+
+type giftServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGiftServiceClient(cc grpc.ClientConnInterface) GiftServiceClient {
+	return &giftServiceClient{cc}
+}
+
+func (c *giftServiceClient) ListGifts(ctx context.Context, in *ListGiftsRequest, opts ...grpc.CallOption) (*ListGiftsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGiftsResponse)
+	err := c.cc.Invoke(ctx, GiftService_ListGifts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *giftServiceClient) GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWalletResponse)
+	err := c.cc.Invoke(ctx, GiftService_GetWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *giftServiceClient) Recharge(ctx context.Context, in *RechargeRequest, opts ...grpc.CallOption) (*RechargeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RechargeResponse)
+	err := c.cc.Invoke(ctx, GiftService_Recharge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *giftServiceClient) SendGift(ctx context.Context, in *SendGiftRequest, opts ...grpc.CallOption) (*SendGiftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendGiftResponse)
+	err := c.cc.Invoke(ctx, GiftService_SendGift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *giftServiceClient) ListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest, opts ...grpc.CallOption) (*ListLedgerEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLedgerEntriesResponse)
+	err := c.cc.Invoke(ctx, GiftService_ListLedgerEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *giftServiceClient) ReconcileWallet(ctx context.Context, in *ReconcileWalletRequest, opts ...grpc.CallOption) (*ReconcileWalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileWalletResponse)
+	err := c.cc.Invoke(ctx, GiftService_ReconcileWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *giftServiceClient) Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthResponse)
+	err := c.cc.Invoke(ctx, GiftService_Health_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GiftServiceServer is the server API for GiftService service.
+// All implementations must embed UnimplementedGiftServiceServer
+// for forward compatibility.
+//
+// 业务错误通过 gRPC 状态码返回，ErrorInfo 详情携带错误原因与稳定的数字错误码（metadata.code），
+// 同时附带 common.Response 详情，其 code/message 与迁移前失败响应中的字段相同。
+// 响应中的 code/message 字段仅为兼容保留，成功时为 0/success
+type GiftServiceServer interface {
+	// 获取礼物列表
+	ListGifts(context.Context, *ListGiftsRequest) (*ListGiftsResponse, error)
+	// 获取钱包余额
+	GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error)
+	// 充值（由支付回调调用，order_no 保证幂等）
+	Recharge(context.Context, *RechargeRequest) (*RechargeResponse, error)
+	// 赠送礼物
+	SendGift(context.Context, *SendGiftRequest) (*SendGiftResponse, error)
+	// 分页获取账户流水
+	ListLedgerEntries(context.Context, *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error)
+	// 根据流水重算余额并与钱包对账
+	ReconcileWallet(context.Context, *ReconcileWalletRequest) (*ReconcileWalletResponse, error)
+	// 健康检查
+	Health(context.Context, *HealthRequest) (*HealthResponse, error)
+	mustEmbedUnimplementedGiftServiceServer()
+}
+
+// UnimplementedGiftServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGiftServiceServer struct{}
+
+func (UnimplementedGiftServiceServer) ListGifts(context.Context, *ListGiftsRequest) (*ListGiftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGifts not implemented")
+}
+func (UnimplementedGiftServiceServer) GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWallet not implemented")
+}
+func (UnimplementedGiftServiceServer) Recharge(context.Context, *RechargeRequest) (*RechargeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Recharge not implemented")
+}
+func (UnimplementedGiftServiceServer) SendGift(context.Context, *SendGiftRequest) (*SendGiftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendGift not implemented")
+}
+func (UnimplementedGiftServiceServer) ListLedgerEntries(context.Context, *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLedgerEntries not implemented")
+}
+func (UnimplementedGiftServiceServer) ReconcileWallet(context.Context, *ReconcileWalletRequest) (*ReconcileWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileWallet not implemented")
+}
+func (UnimplementedGiftServiceServer) Health(context.Context, *HealthRequest) (*HealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
+func (UnimplementedGiftServiceServer) mustEmbedUnimplementedGiftServiceServer() {}
+func (UnimplementedGiftServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeGiftServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GiftServiceServer will
+// result in compilation errors.
+type UnsafeGiftServiceServer interface {
+	mustEmbedUnimplementedGiftServiceServer()
+}
+
+func RegisterGiftServiceServer(s grpc.ServiceRegistrar, srv GiftServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGiftServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GiftService_ServiceDesc, srv)
+}
+
+func _GiftService_ListGifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGiftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiftServiceServer).ListGifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiftService_ListGifts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiftServiceServer).ListGifts(ctx, req.(*ListGiftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GiftService_GetWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiftServiceServer).GetWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiftService_GetWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiftServiceServer).GetWallet(ctx, req.(*GetWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GiftService_Recharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RechargeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiftServiceServer).Recharge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiftService_Recharge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiftServiceServer).Recharge(ctx, req.(*RechargeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GiftService_SendGift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendGiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiftServiceServer).SendGift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiftService_SendGift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiftServiceServer).SendGift(ctx, req.(*SendGiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GiftService_ListLedgerEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLedgerEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiftServiceServer).ListLedgerEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiftService_ListLedgerEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiftServiceServer).ListLedgerEntries(ctx, req.(*ListLedgerEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GiftService_ReconcileWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiftServiceServer).ReconcileWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiftService_ReconcileWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiftServiceServer).ReconcileWallet(ctx, req.(*ReconcileWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GiftService_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiftServiceServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiftService_Health_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiftServiceServer).Health(ctx, req.(*HealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GiftService_ServiceDesc is the grpc.ServiceDesc for GiftService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GiftService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gift.GiftService",
+	HandlerType: (*GiftServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListGifts",
+			Handler:    _GiftService_ListGifts_Handler,
+		},
+		{
+			MethodName: "GetWallet",
+			Handler:    _GiftService_GetWallet_Handler,
+		},
+		{
+			MethodName: "Recharge",
+			Handler:    _GiftService_Recharge_Handler,
+		},
+		{
+			MethodName: "SendGift",
+			Handler:    _GiftService_SendGift_Handler,
+		},
+		{
+			MethodName: "ListLedgerEntries",
+			Handler:    _GiftService_ListLedgerEntries_Handler,
+		},
+		{
+			MethodName: "ReconcileWallet",
+			Handler:    _GiftService_ReconcileWallet_Handler,
+		},
+		{
+			MethodName: "Health",
+			Handler:    _GiftService_Health_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gift/gift.proto",
+}
@@ -0,0 +1,532 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: room/room.proto
+
+package room
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	common "live-stream-platform/gen/proto/common"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RoomService_CreateRoom_FullMethodName        = "/room.RoomService/CreateRoom"
+	RoomService_GetRoom_FullMethodName           = "/room.RoomService/GetRoom"
+	RoomService_UpdateRoom_FullMethodName        = "/room.RoomService/UpdateRoom"
+	RoomService_StartLive_FullMethodName         = "/room.RoomService/StartLive"
+	RoomService_StopLive_FullMethodName          = "/room.RoomService/StopLive"
+	RoomService_ListLiveRooms_FullMethodName     = "/room.RoomService/ListLiveRooms"
+	RoomService_GenerateStreamKey_FullMethodName = "/room.RoomService/GenerateStreamKey"
+	RoomService_AuthorizePublish_FullMethodName  = "/room.RoomService/AuthorizePublish"
+	RoomService_PublishDone_FullMethodName       = "/room.RoomService/PublishDone"
+	RoomService_ForceStopLive_FullMethodName     = "/room.RoomService/ForceStopLive"
+	RoomService_Health_FullMethodName            = "/room.RoomService/Health"
+)
+
+// RoomServiceClient is the client API for RoomService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 业务错误通过 gRPC 状态码返回，ErrorInfo 详情携带错误原因与稳定的数字错误码（metadata.code），
+// 同时附带 common.Response 详情，其 code/message 与迁移前失败响应中的字段相同。
+// 响应中的 code/message 字段仅为兼容保留，成功时为 0/success
+type RoomServiceClient interface {
+	// 创建直播间
+	CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*CreateRoomResponse, error)
+	// 获取直播间信息
+	GetRoom(ctx context.Context, in *GetRoomRequest, opts ...grpc.CallOption) (*GetRoomResponse, error)
+	// 更新直播间信息
+	UpdateRoom(ctx context.Context, in *UpdateRoomRequest, opts ...grpc.CallOption) (*common.Response, error)
+	// 开始直播
+	StartLive(ctx context.Context, in *StartLiveRequest, opts ...grpc.CallOption) (*common.Response, error)
+	// 结束直播
+	StopLive(ctx context.Context, in *StopLiveRequest, opts ...grpc.CallOption) (*common.Response, error)
+	// 分页获取直播中的房间
+	ListLiveRooms(ctx context.Context, in *ListLiveRoomsRequest, opts ...grpc.CallOption) (*ListLiveRoomsResponse, error)
+	// 生成推流密钥，已存在时轮换（旧密钥立即失效）
+	GenerateStreamKey(ctx context.Context, in *GenerateStreamKeyRequest, opts ...grpc.CallOption) (*GenerateStreamKeyResponse, error)
+	// 媒体服务器推流鉴权回调，通过后房间自动开播
+	AuthorizePublish(ctx context.Context, in *AuthorizePublishRequest, opts ...grpc.CallOption) (*common.Response, error)
+	// 媒体服务器推流结束回调，房间自动下播
+	PublishDone(ctx context.Context, in *PublishDoneRequest, opts ...grpc.CallOption) (*common.Response, error)
+	// 强制结束直播（管理员操作），同时作废推流密钥
+	ForceStopLive(ctx context.Context, in *ForceStopLiveRequest, opts ...grpc.CallOption) (*common.Response, error)
+	// 健康检查
+	Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
+}
+
+type roomServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoomServiceClient(cc grpc.ClientConnInterface) RoomServiceClient {
+	return &roomServiceClient{cc}
+}
+
+func (c *roomServiceClient) CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*CreateRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRoomResponse)
+	err := c.cc.Invoke(ctx, RoomService_CreateRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) GetRoom(ctx context.Context, in *GetRoomRequest, opts ...grpc.CallOption) (*GetRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRoomResponse)
+	err := c.cc.Invoke(ctx, RoomService_GetRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) UpdateRoom(ctx context.Context, in *UpdateRoomRequest, opts ...grpc.CallOption) (*common.Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Response)
+	err := c.cc.Invoke(ctx, RoomService_UpdateRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) StartLive(ctx context.Context, in *StartLiveRequest, opts ...grpc.CallOption) (*common.Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Response)
+	err := c.cc.Invoke(ctx, RoomService_StartLive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) StopLive(ctx context.Context, in *StopLiveRequest, opts ...grpc.CallOption) (*common.Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Response)
+	err := c.cc.Invoke(ctx, RoomService_StopLive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) ListLiveRooms(ctx context.Context, in *ListLiveRoomsRequest, opts ...grpc.CallOption) (*ListLiveRoomsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLiveRoomsResponse)
+	err := c.cc.Invoke(ctx, RoomService_ListLiveRooms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) GenerateStreamKey(ctx context.Context, in *GenerateStreamKeyRequest, opts ...grpc.CallOption) (*GenerateStreamKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateStreamKeyResponse)
+	err := c.cc.Invoke(ctx, RoomService_GenerateStreamKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) AuthorizePublish(ctx context.Context, in *AuthorizePublishRequest, opts ...grpc.CallOption) (*common.Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Response)
+	err := c.cc.Invoke(ctx, RoomService_AuthorizePublish_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) PublishDone(ctx context.Context, in *PublishDoneRequest, opts ...grpc.CallOption) (*common.Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Response)
+	err := c.cc.Invoke(ctx, RoomService_PublishDone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) ForceStopLive(ctx context.Context, in *ForceStopLiveRequest, opts ...grpc.CallOption) (*common.Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Response)
+	err := c.cc.Invoke(ctx, RoomService_ForceStopLive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthResponse)
+	err := c.cc.Invoke(ctx, RoomService_Health_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RoomServiceServer is the server API for RoomService service.
+// All implementations must embed UnimplementedRoomServiceServer
+// for forward compatibility.
+//
+// 业务错误通过 gRPC 状态码返回，ErrorInfo 详情携带错误原因与稳定的数字错误码（metadata.code），
+// 同时附带 common.Response 详情，其 code/message 与迁移前失败响应中的字段相同。
+// 响应中的 code/message 字段仅为兼容保留，成功时为 0/success
+type RoomServiceServer interface {
+	// 创建直播间
+	CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error)
+	// 获取直播间信息
+	GetRoom(context.Context, *GetRoomRequest) (*GetRoomResponse, error)
+	// 更新直播间信息
+	UpdateRoom(context.Context, *UpdateRoomRequest) (*common.Response, error)
+	// 开始直播
+	StartLive(context.Context, *StartLiveRequest) (*common.Response, error)
+	// 结束直播
+	StopLive(context.Context, *StopLiveRequest) (*common.Response, error)
+	// 分页获取直播中的房间
+	ListLiveRooms(context.Context, *ListLiveRoomsRequest) (*ListLiveRoomsResponse, error)
+	// 生成推流密钥，已存在时轮换（旧密钥立即失效）
+	GenerateStreamKey(context.Context, *GenerateStreamKeyRequest) (*GenerateStreamKeyResponse, error)
+	// 媒体服务器推流鉴权回调，通过后房间自动开播
+	AuthorizePublish(context.Context, *AuthorizePublishRequest) (*common.Response, error)
+	// 媒体服务器推流结束回调，房间自动下播
+	PublishDone(context.Context, *PublishDoneRequest) (*common.Response, error)
+	// 强制结束直播（管理员操作），同时作废推流密钥
+	ForceStopLive(context.Context, *ForceStopLiveRequest) (*common.Response, error)
+	// 健康检查
+	Health(context.Context, *HealthRequest) (*HealthResponse, error)
+	mustEmbedUnimplementedRoomServiceServer()
+}
+
+// UnimplementedRoomServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRoomServiceServer struct{}
+
+func (UnimplementedRoomServiceServer) CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoom not implemented")
+}
+func (UnimplementedRoomServiceServer) GetRoom(context.Context, *GetRoomRequest) (*GetRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoom not implemented")
+}
+func (UnimplementedRoomServiceServer) UpdateRoom(context.Context, *UpdateRoomRequest) (*common.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoom not implemented")
+}
+func (UnimplementedRoomServiceServer) StartLive(context.Context, *StartLiveRequest) (*common.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartLive not implemented")
+}
+func (UnimplementedRoomServiceServer) StopLive(context.Context, *StopLiveRequest) (*common.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopLive not implemented")
+}
+func (UnimplementedRoomServiceServer) ListLiveRooms(context.Context, *ListLiveRoomsRequest) (*ListLiveRoomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLiveRooms not implemented")
+}
+func (UnimplementedRoomServiceServer) GenerateStreamKey(context.Context, *GenerateStreamKeyRequest) (*GenerateStreamKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateStreamKey not implemented")
+}
+func (UnimplementedRoomServiceServer) AuthorizePublish(context.Context, *AuthorizePublishRequest) (*common.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizePublish not implemented")
+}
+func (UnimplementedRoomServiceServer) PublishDone(context.Context, *PublishDoneRequest) (*common.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishDone not implemented")
+}
+func (UnimplementedRoomServiceServer) ForceStopLive(context.Context, *ForceStopLiveRequest) (*common.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceStopLive not implemented")
+}
+func (UnimplementedRoomServiceServer) Health(context.Context, *HealthRequest) (*HealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
+func (UnimplementedRoomServiceServer) mustEmbedUnimplementedRoomServiceServer() {}
+func (UnimplementedRoomServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeRoomServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RoomServiceServer will
+// result in compilation errors.
+type UnsafeRoomServiceServer interface {
+	mustEmbedUnimplementedRoomServiceServer()
+}
+
+func RegisterRoomServiceServer(s grpc.ServiceRegistrar, srv RoomServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRoomServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RoomService_ServiceDesc, srv)
+}
+
+func _RoomService_CreateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).CreateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_CreateRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).CreateRoom(ctx, req.(*CreateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_GetRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).GetRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_GetRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).GetRoom(ctx, req.(*GetRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_UpdateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).UpdateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_UpdateRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).UpdateRoom(ctx, req.(*UpdateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_StartLive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartLiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).StartLive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_StartLive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).StartLive(ctx, req.(*StartLiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_StopLive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopLiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).StopLive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_StopLive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).StopLive(ctx, req.(*StopLiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_ListLiveRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLiveRoomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).ListLiveRooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_ListLiveRooms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).ListLiveRooms(ctx, req.(*ListLiveRoomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_GenerateStreamKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateStreamKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).GenerateStreamKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_GenerateStreamKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).GenerateStreamKey(ctx, req.(*GenerateStreamKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_AuthorizePublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizePublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).AuthorizePublish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_AuthorizePublish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).AuthorizePublish(ctx, req.(*AuthorizePublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_PublishDone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishDoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).PublishDone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_PublishDone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).PublishDone(ctx, req.(*PublishDoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_ForceStopLive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceStopLiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).ForceStopLive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_ForceStopLive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).ForceStopLive(ctx, req.(*ForceStopLiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_Health_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).Health(ctx, req.(*HealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RoomService_ServiceDesc is the grpc.ServiceDesc for RoomService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RoomService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "room.RoomService",
+	HandlerType: (*RoomServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRoom",
+			Handler:    _RoomService_CreateRoom_Handler,
+		},
+		{
+			MethodName: "GetRoom",
+			Handler:    _RoomService_GetRoom_Handler,
+		},
+		{
+			MethodName: "UpdateRoom",
+			Handler:    _RoomService_UpdateRoom_Handler,
+		},
+		{
+			MethodName: "StartLive",
+			Handler:    _RoomService_StartLive_Handler,
+		},
+		{
+			MethodName: "StopLive",
+			Handler:    _RoomService_StopLive_Handler,
+		},
+		{
+			MethodName: "ListLiveRooms",
+			Handler:    _RoomService_ListLiveRooms_Handler,
+		},
+		{
+			MethodName: "GenerateStreamKey",
+			Handler:    _RoomService_GenerateStreamKey_Handler,
+		},
+		{
+			MethodName: "AuthorizePublish",
+			Handler:    _RoomService_AuthorizePublish_Handler,
+		},
+		{
+			MethodName: "PublishDone",
+			Handler:    _RoomService_PublishDone_Handler,
+		},
+		{
+			MethodName: "ForceStopLive",
+			Handler:    _RoomService_ForceStopLive_Handler,
+		},
+		{
+			MethodName: "Health",
+			Handler:    _RoomService_Health_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "room/room.proto",
+}
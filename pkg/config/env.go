@@ -0,0 +1,217 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// applyEnv 用环境变量覆盖配置，无法解析的值返回错误而不是静默忽略
+func applyEnv(c *Config) error {
+	e := &envLoader{}
+	e.string(&c.Server.Port, "SERVER_PORT")
+	e.string(&c.Server.Env, "SERVER_ENV")
+	e.int(&c.Server.ReadTimeout, "SERVER_READ_TIMEOUT")
+	e.int(&c.Server.WriteTimeout, "SERVER_WRITE_TIMEOUT")
+
+	e.string(&c.Database.Host, "DB_HOST")
+	e.string(&c.Database.Port, "DB_PORT")
+	e.string(&c.Database.User, "DB_USER")
+	e.string(&c.Database.Password, "DB_PASSWORD")
+	e.string(&c.Database.Database, "DB_NAME")
+	e.int(&c.Database.MaxOpenConns, "DB_MAX_OPEN_CONNS")
+	e.int(&c.Database.MaxIdleConns, "DB_MAX_IDLE_CONNS")
+	e.duration(&c.Database.MaxLifetime, "DB_MAX_LIFETIME")
+	e.millis(&c.Database.SlowThreshold, "DB_SLOW_QUERY_MS")
+	e.optionalBool(&c.Database.AutoMigrate, "DB_AUTO_MIGRATE")
+	e.replicas(&c.Database.Replicas, "DB_REPLICAS")
+	e.duration(&c.Database.MaxReplicaLag, "DB_MAX_REPLICA_LAG")
+
+	e.string(&c.Redis.Host, "REDIS_HOST")
+	e.string(&c.Redis.Port, "REDIS_PORT")
+	e.string(&c.Redis.Password, "REDIS_PASSWORD")
+	e.int(&c.Redis.DB, "REDIS_DB")
+
+	e.string(&c.RabbitMQ.URL, "RABBITMQ_URL")
+	e.string(&c.RabbitMQ.Exchange, "RABBITMQ_EXCHANGE")
+	e.string(&c.RabbitMQ.Prefix, "RABBITMQ_QUEUE_PREFIX")
+	e.duration(&c.RabbitMQ.ConfirmTimeout, "RABBITMQ_CONFIRM_TIMEOUT")
+
+	e.string(&c.JWT.Secret, "JWT_SECRET")
+	e.int(&c.JWT.AccessExpireMinutes, "JWT_ACCESS_EXPIRE_MINUTES")
+	e.int(&c.JWT.RefreshExpireHours, "JWT_REFRESH_EXPIRE_HOURS")
+
+	e.string(&c.Services.UserService, "USER_SERVICE_ADDR")
+	e.string(&c.Services.RoomService, "ROOM_SERVICE_ADDR")
+	e.string(&c.Services.GiftService, "GIFT_SERVICE_ADDR")
+	e.string(&c.Services.AdminService, "ADMIN_SERVICE_ADDR")
+
+	e.string(&c.Metrics.Port, "METRICS_PORT")
+
+	e.string(&c.Tracing.Exporter, "TRACING_EXPORTER")
+	e.string(&c.Tracing.Endpoint, "OTEL_EXPORTER_OTLP_ENDPOINT")
+	e.bool(&c.Tracing.Insecure, "OTEL_EXPORTER_OTLP_INSECURE")
+	e.float(&c.Tracing.SampleRatio, "TRACING_SAMPLE_RATIO")
+
+	e.string(&c.Log.Level, "LOG_LEVEL")
+	e.string(&c.Log.Format, "LOG_FORMAT")
+
+	e.duration(&c.RateLimit.DanmakuInterval, "DANMAKU_MIN_INTERVAL")
+
+	e.string(&c.Mail.Driver, "MAIL_DRIVER")
+	e.string(&c.Mail.From, "MAIL_FROM")
+	e.string(&c.Mail.SMTPHost, "SMTP_HOST")
+	e.string(&c.Mail.SMTPPort, "SMTP_PORT")
+	e.string(&c.Mail.SMTPUsername, "SMTP_USERNAME")
+	e.string(&c.Mail.SMTPPassword, "SMTP_PASSWORD")
+	e.string(&c.Mail.Dir, "MAIL_DIR")
+	e.string(&c.Mail.BaseURL, "MAIL_BASE_URL")
+	e.string(&c.Mail.TokenSecret, "MAIL_TOKEN_SECRET")
+	e.duration(&c.Mail.VerifyTokenTTL, "MAIL_VERIFY_TOKEN_TTL")
+	e.duration(&c.Mail.ResetTokenTTL, "MAIL_RESET_TOKEN_TTL")
+	e.duration(&c.Mail.ResendCooldown, "MAIL_RESEND_COOLDOWN")
+
+	e.string(&c.RTMP.HookSecret, "RTMP_HOOK_SECRET")
+	e.list(&c.RTMP.HookAllowedIPs, "RTMP_HOOK_ALLOWED_IPS")
+	return errors.Join(e.errs...)
+}
+
+// envLoader 收集所有解析错误，一次性报告
+type envLoader struct {
+	errs []error
+}
+
+// lookup 读取 KEY，未设置时读取 KEY_FILE 指向的文件（Docker/K8s secrets）
+func (e *envLoader) lookup(key string) (string, bool) {
+	if value := os.Getenv(key); value != "" {
+		return value, true
+	}
+	path := os.Getenv(key + "_FILE")
+	if path == "" {
+		return "", false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		e.errs = append(e.errs, fmt.Errorf("%s_FILE: %w", key, err))
+		return "", false
+	}
+	return strings.TrimRight(string(data), "\r\n"), true
+}
+
+func (e *envLoader) string(target *string, key string) {
+	if value, ok := e.lookup(key); ok {
+		*target = value
+	}
+}
+
+func (e *envLoader) int(target *int, key string) {
+	if value, ok := e.lookup(key); ok {
+		v, err := strconv.Atoi(value)
+		if err != nil {
+			e.errs = append(e.errs, fmt.Errorf("%s: invalid integer %q", key, value))
+			return
+		}
+		*target = v
+	}
+}
+
+func (e *envLoader) bool(target *bool, key string) {
+	if value, ok := e.lookup(key); ok {
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			e.errs = append(e.errs, fmt.Errorf("%s: invalid boolean %q", key, value))
+			return
+		}
+		*target = v
+	}
+}
+
+// optionalBool 未设置的布尔项保持 nil，由 resolve 按环境决定
+func (e *envLoader) optionalBool(target **bool, key string) {
+	if value, ok := e.lookup(key); ok {
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			e.errs = append(e.errs, fmt.Errorf("%s: invalid boolean %q", key, value))
+			return
+		}
+		*target = &v
+	}
+}
+
+func (e *envLoader) float(target *float64, key string) {
+	if value, ok := e.lookup(key); ok {
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			e.errs = append(e.errs, fmt.Errorf("%s: invalid number %q", key, value))
+			return
+		}
+		*target = v
+	}
+}
+
+// duration 解析 1h、30s 这样的时长
+func (e *envLoader) duration(target *time.Duration, key string) {
+	if value, ok := e.lookup(key); ok {
+		v, err := time.ParseDuration(value)
+		if err != nil {
+			e.errs = append(e.errs, fmt.Errorf("%s: invalid duration %q", key, value))
+			return
+		}
+		*target = v
+	}
+}
+
+// millis 兼容以毫秒整数配置的旧环境变量
+func (e *envLoader) millis(target *time.Duration, key string) {
+	if value, ok := e.lookup(key); ok {
+		v, err := strconv.Atoi(value)
+		if err != nil {
+			e.errs = append(e.errs, fmt.Errorf("%s: invalid integer %q", key, value))
+			return
+		}
+		*target = time.Duration(v) * time.Millisecond
+	}
+}
+
+// list 解析逗号分隔的列表，忽略空项
+func (e *envLoader) list(target *[]string, key string) {
+	value, ok := e.lookup(key)
+	if !ok {
+		return
+	}
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	*target = items
+}
+
+// replicas 解析逗号分隔的 host:port 列表，端口缺省为 3306
+func (e *envLoader) replicas(target *[]ReplicaConfig, key string) {
+	value, ok := e.lookup(key)
+	if !ok {
+		return
+	}
+	var replicas []ReplicaConfig
+	for _, addr := range strings.Split(value, ",") {
+		addr = strings.TrimSpace(addr)
+		if addr == "" {
+			continue
+		}
+		host, port, found := strings.Cut(addr, ":")
+		if !found {
+			port = "3306"
+		}
+		if host == "" || port == "" {
+			e.errs = append(e.errs, fmt.Errorf("%s: invalid address %q", key, addr))
+			return
+		}
+		replicas = append(replicas, ReplicaConfig{Host: host, Port: port})
+	}
+	*target = replicas
+}
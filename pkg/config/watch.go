@@ -0,0 +1,52 @@
+package config
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+)
+
+// DefaultWatchInterval 检查配置文件变更的间隔
+const DefaultWatchInterval = 10 * time.Second
+
+// Watch 轮询 CONFIG_FILE 的修改时间（兼容 K8s ConfigMap 的符号链接替换），
+// 变更后重新加载并校验，成功时调用 apply。只有日志级别、限流这类可安全热更新的配置应在 apply 中生效，
+// 端口、连接地址、密钥等仍需重启。未配置文件时直接返回
+func Watch(ctx context.Context, interval time.Duration, apply func(*Config)) {
+	path := os.Getenv(FileEnv)
+	if path == "" {
+		return
+	}
+	lastMod := modTime(path)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			mod := modTime(path)
+			if mod.IsZero() || mod.Equal(lastMod) {
+				continue
+			}
+			lastMod = mod
+			cfg, err := Load()
+			if err != nil {
+				// 保留旧配置继续运行
+				slog.Error("Failed to reload config", slog.String("path", path), slog.Any("error", err))
+				continue
+			}
+			apply(cfg)
+			slog.Info("Config reloaded", slog.String("path", path))
+		}
+	}
+}
+
+func modTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
@@ -1,30 +1,40 @@
 package database
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"log"
-	"time"
+	"io/fs"
+	"log/slog"
 
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
 	"live-stream-platform/pkg/config"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/pkg/metrics"
+	"live-stream-platform/pkg/migrate"
+	"live-stream-platform/pkg/tracing"
 )
 
 var DB *gorm.DB
 
-func Init(cfg *config.DatabaseConfig) error {
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
+// replicas 配置了从库时负责读写分离
+var replicas *resolver
+
+func dsn(cfg *config.DatabaseConfig, host, port string) string {
+	return fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
 		cfg.User,
 		cfg.Password,
-		cfg.Host,
-		cfg.Port,
+		host,
+		port,
 		cfg.Database,
 	)
+}
 
+func Init(cfg *config.DatabaseConfig) error {
 	var err error
-	DB, err = gorm.Open(mysql.Open(dsn), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+	DB, err = gorm.Open(mysql.Open(dsn(cfg, cfg.Host, cfg.Port)), &gorm.Config{
+		Logger: logger.NewGormLogger(cfg.SlowThreshold),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to connect database: %w", err)
@@ -37,13 +47,34 @@ func Init(cfg *config.DatabaseConfig) error {
 
 	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
 	sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
-	sqlDB.SetConnMaxLifetime(time.Hour)
+	sqlDB.SetConnMaxLifetime(cfg.MaxLifetime)
+
+	if err := metrics.InstrumentGORM(DB, cfg.Database); err != nil {
+		return fmt.Errorf("failed to instrument database: %w", err)
+	}
+	if err := tracing.InstrumentGORM(DB); err != nil {
+		return fmt.Errorf("failed to instrument database: %w", err)
+	}
 
-	log.Println("Database connected successfully")
+	if len(cfg.Replicas) > 0 {
+		if replicas, err = newResolver(sqlDB, cfg); err != nil {
+			return err
+		}
+		if err := replicas.register(DB); err != nil {
+			return fmt.Errorf("failed to register replica resolver: %w", err)
+		}
+		logger.Info(context.Background(), "Database replicas configured", slog.Int("count", len(cfg.Replicas)))
+	}
+
+	logger.Info(context.Background(), "Database connected successfully")
 	return nil
 }
 
 func Close() error {
+	if replicas != nil {
+		replicas.close()
+		replicas = nil
+	}
 	sqlDB, err := DB.DB()
 	if err != nil {
 		return err
@@ -54,3 +85,32 @@ func Close() error {
 func GetDB() *gorm.DB {
 	return DB
 }
+
+// Migrate 执行 service 未执行的迁移，多个实例同时启动时由咨询锁保证只执行一次
+func Migrate(ctx context.Context, service string, fsys fs.FS) error {
+	sqlDB, err := DB.DB()
+	if err != nil {
+		return err
+	}
+	m, err := migrate.New(sqlDB, service, fsys)
+	if err != nil {
+		return err
+	}
+	applied, err := m.Up(ctx)
+	for _, mig := range applied {
+		logger.Info(ctx, "Migration applied", slog.Int64("version", mig.Version), slog.String("name", mig.Name))
+	}
+	return err
+}
+
+// Ping 检查数据库连接是否可用
+func Ping(ctx context.Context) error {
+	if DB == nil {
+		return errors.New("database not initialized")
+	}
+	sqlDB, err := DB.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
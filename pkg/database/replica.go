@@ -0,0 +1,248 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/gorm"
+	"live-stream-platform/pkg/config"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/pkg/metrics"
+)
+
+// replicaCheckInterval 检查从库连通性与复制延迟的间隔
+const replicaCheckInterval = 5 * time.Second
+
+type primaryKey struct{}
+
+// WithPrimary 标记 ctx 上的后续读取都走主库（read your writes）。
+// 写入后需要立即读到新数据，或读-改-写的场景使用；事务与 SELECT ... FOR UPDATE 总是走主库，无需标记
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+func usePrimary(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	v, _ := ctx.Value(primaryKey{}).(bool)
+	return v
+}
+
+// replica 只读从库，healthy 由后台检查维护
+type replica struct {
+	name    string
+	db      *sql.DB
+	healthy atomic.Bool
+}
+
+// resolver 把不在事务中的读语句轮询分发给健康的从库，写语句与标记了 WithPrimary 的读取走主库。
+// 没有健康的从库时回退到主库
+type resolver struct {
+	primary  *sql.DB
+	replicas []*replica
+	maxLag   time.Duration
+	next     atomic.Uint64
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// newResolver 连接所有从库并完成首次健康检查，之后在后台周期性检查
+func newResolver(primary *sql.DB, cfg *config.DatabaseConfig) (*resolver, error) {
+	r := &resolver{primary: primary, maxLag: cfg.MaxReplicaLag, done: make(chan struct{})}
+	for _, rc := range cfg.Replicas {
+		// mysql 驱动已由 gorm.io/driver/mysql 注册
+		db, err := sql.Open("mysql", dsn(cfg, rc.Host, rc.Port))
+		if err != nil {
+			r.closeReplicas()
+			return nil, fmt.Errorf("failed to open replica %s:%s: %w", rc.Host, rc.Port, err)
+		}
+		db.SetMaxOpenConns(cfg.MaxOpenConns)
+		db.SetMaxIdleConns(cfg.MaxIdleConns)
+		db.SetConnMaxLifetime(cfg.MaxLifetime)
+		name := rc.Host + ":" + rc.Port
+		metrics.InstrumentSQL(db, cfg.Database+"@"+name)
+		r.replicas = append(r.replicas, &replica{name: name, db: db})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.check(ctx)
+	go r.run(ctx)
+	return r, nil
+}
+
+// register 在查询前选择连接池。写语句也需要注册：复用同一个 *gorm.DB 链式调用时，
+// 上一次读取选中的从库会留在 Statement 上，必须在开启事务前换回主库
+func (r *resolver) register(db *gorm.DB) error {
+	cb := db.Callback()
+	steps := []error{
+		cb.Query().Before("gorm:query").Register("database:route_query", r.routeRead),
+		cb.Row().Before("gorm:row").Register("database:route_row", r.routeRead),
+		cb.Create().Before("gorm:begin_transaction").Register("database:route_create", r.routeWrite),
+		cb.Update().Before("gorm:begin_transaction").Register("database:route_update", r.routeWrite),
+		cb.Delete().Before("gorm:begin_transaction").Register("database:route_delete", r.routeWrite),
+		cb.Raw().Before("gorm:raw").Register("database:route_raw", r.routeWrite),
+	}
+	return errors.Join(steps...)
+}
+
+func (r *resolver) routeRead(db *gorm.DB) {
+	stmt := db.Statement
+	// 事务中的 ConnPool 是 *sql.Tx，保持不变
+	if _, ok := stmt.ConnPool.(*sql.DB); !ok {
+		return
+	}
+	if usePrimary(stmt.Context) || r.locking(stmt) || !isSelect(stmt.SQL.String()) {
+		stmt.ConnPool = r.primary
+		metrics.ObserveRead("primary")
+		return
+	}
+	if rep := r.pick(); rep != nil {
+		stmt.ConnPool = rep.db
+		metrics.ObserveRead("replica")
+		return
+	}
+	stmt.ConnPool = r.primary
+	metrics.ObserveRead("primary")
+}
+
+func (r *resolver) routeWrite(db *gorm.DB) {
+	if _, ok := db.Statement.ConnPool.(*sql.DB); ok {
+		db.Statement.ConnPool = r.primary
+	}
+}
+
+// locking SELECT ... FOR UPDATE / FOR SHARE 必须读主库
+func (r *resolver) locking(stmt *gorm.Statement) bool {
+	_, ok := stmt.Clauses["FOR"]
+	return ok
+}
+
+// isSelect 语句尚未构建（普通查询）或 Raw 的 SQL 是 SELECT
+func isSelect(sql string) bool {
+	sql = strings.TrimSpace(sql)
+	return sql == "" || (len(sql) >= 6 && strings.EqualFold(sql[:6], "select"))
+}
+
+// pick 轮询选择一个健康的从库，没有时返回 nil
+func (r *resolver) pick() *replica {
+	healthy := make([]*replica, 0, len(r.replicas))
+	for _, rep := range r.replicas {
+		if rep.healthy.Load() {
+			healthy = append(healthy, rep)
+		}
+	}
+	if len(healthy) == 0 {
+		return nil
+	}
+	return healthy[r.next.Add(1)%uint64(len(healthy))]
+}
+
+func (r *resolver) run(ctx context.Context) {
+	defer close(r.done)
+	ticker := time.NewTicker(replicaCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.check(ctx)
+		}
+	}
+}
+
+// check 并行检查所有从库，连接失败、复制中断或延迟超过 maxLag 的从库不再接收读请求
+func (r *resolver) check(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, rep := range r.replicas {
+		wg.Add(1)
+		go func(rep *replica) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, replicaCheckInterval/2)
+			defer cancel()
+			lag, err := replicationLag(checkCtx, rep.db)
+			if err == nil && lag > r.maxLag {
+				err = fmt.Errorf("replication lag %s exceeds %s", lag, r.maxLag)
+			}
+			healthy := err == nil
+			metrics.ObserveReplica(rep.name, healthy, lag)
+			if rep.healthy.Swap(healthy) == healthy {
+				return
+			}
+			if healthy {
+				logger.Info(ctx, "Database replica is healthy, routing reads to it", slog.String("replica", rep.name))
+			} else {
+				logger.Warn(ctx, "Database replica is unhealthy, reads fall back", slog.String("replica", rep.name), logger.Err(err))
+			}
+		}(rep)
+	}
+	wg.Wait()
+}
+
+// replicationLag 读取 SHOW REPLICA STATUS（MySQL 8.0.22 之前为 SHOW SLAVE STATUS）中的延迟秒数。
+// 账号需要 REPLICATION CLIENT 权限
+func replicationLag(ctx context.Context, db *sql.DB) (time.Duration, error) {
+	rows, err := db.QueryContext(ctx, "SHOW REPLICA STATUS")
+	if err != nil {
+		if rows, err = db.QueryContext(ctx, "SHOW SLAVE STATUS"); err != nil {
+			return 0, err
+		}
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return 0, err
+		}
+		return 0, errors.New("replication is not configured")
+	}
+	values := make([]sql.RawBytes, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return 0, err
+	}
+	for i, column := range columns {
+		if column != "Seconds_Behind_Source" && column != "Seconds_Behind_Master" {
+			continue
+		}
+		// NULL 表示复制线程未运行
+		if values[i] == nil {
+			return 0, errors.New("replication is not running")
+		}
+		seconds, err := strconv.ParseInt(string(values[i]), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid replication lag %q", values[i])
+		}
+		return time.Duration(seconds) * time.Second, nil
+	}
+	return 0, errors.New("replication lag column not found")
+}
+
+func (r *resolver) close() {
+	r.cancel()
+	<-r.done
+	r.closeReplicas()
+}
+
+func (r *resolver) closeReplicas() {
+	for _, rep := range r.replicas {
+		rep.db.Close()
+	}
+}
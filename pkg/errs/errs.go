@@ -0,0 +1,137 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kind 错误类别，决定映射到的 gRPC 状态码
+type Kind int
+
+const (
+	KindInternal Kind = iota
+	KindInvalidArgument
+	KindNotFound
+	KindAlreadyExists
+	KindUnauthenticated
+	KindPermissionDenied
+	KindResourceExhausted
+)
+
+// 各类别的默认业务错误码，业务域可定义更细的错误码
+const (
+	CodeInvalidArgument  int32 = 40000
+	CodeUnauthenticated  int32 = 40100
+	CodePermissionDenied int32 = 40300
+	CodeNotFound         int32 = 40400
+	CodeAlreadyExists    int32 = 40900
+	CodeTooManyRequests  int32 = 42900
+	CodeInternal         int32 = 50000
+)
+
+// FieldViolation 参数校验失败的字段
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// Error 领域错误：Code 是给旧客户端的稳定数字码，Reason 是机器可读的原因，Message 可以直接展示给调用方
+type Error struct {
+	Kind    Kind
+	Code    int32
+	Reason  string
+	Message string
+	Fields  []FieldViolation
+	cause   error
+}
+
+// New 创建领域错误
+func New(kind Kind, code int32, reason, message string) *Error {
+	return &Error{Kind: kind, Code: code, Reason: reason, Message: message}
+}
+
+// InvalidArgument 参数错误
+func InvalidArgument(code int32, reason, message string) *Error {
+	return New(KindInvalidArgument, code, reason, message)
+}
+
+// NotFound 资源不存在
+func NotFound(code int32, reason, message string) *Error {
+	return New(KindNotFound, code, reason, message)
+}
+
+// AlreadyExists 资源已存在
+func AlreadyExists(code int32, reason, message string) *Error {
+	return New(KindAlreadyExists, code, reason, message)
+}
+
+// Unauthenticated 未认证或凭证无效
+func Unauthenticated(code int32, reason, message string) *Error {
+	return New(KindUnauthenticated, code, reason, message)
+}
+
+// PermissionDenied 无权限
+func PermissionDenied(code int32, reason, message string) *Error {
+	return New(KindPermissionDenied, code, reason, message)
+}
+
+// ResourceExhausted 配额或频率限制
+func ResourceExhausted(code int32, reason, message string) *Error {
+	return New(KindResourceExhausted, code, reason, message)
+}
+
+// Internal 内部错误，原始错误只用于日志，不会返回给调用方
+func Internal(err error) *Error {
+	return &Error{Kind: KindInternal, Code: CodeInternal, Reason: "INTERNAL", Message: "internal error", cause: err}
+}
+
+// Wrap 包装基础设施错误为内部错误；err 已是领域错误时原样返回
+func Wrap(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return err
+	}
+	return Internal(fmt.Errorf("%s: %w", msg, err))
+}
+
+// Error 包含原始错误，用于日志
+func (e *Error) Error() string {
+	if e.cause != nil {
+		return e.Message + ": " + e.cause.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Is 按 Reason 判断是否同一类错误，附加字段或改写消息不影响比较
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Reason == e.Reason
+}
+
+// WithField 返回附加了字段校验信息的副本
+func (e *Error) WithField(field, description string) *Error {
+	c := *e
+	c.Fields = append(append([]FieldViolation(nil), e.Fields...), FieldViolation{Field: field, Description: description})
+	return &c
+}
+
+// WithMessage 返回替换了消息的副本
+func (e *Error) WithMessage(message string) *Error {
+	c := *e
+	c.Message = message
+	return &c
+}
+
+// As 从错误链中取出领域错误
+func As(err error) (*Error, bool) {
+	var e *Error
+	ok := errors.As(err, &e)
+	return e, ok
+}
@@ -0,0 +1,132 @@
+package errs
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	commonPb "live-stream-platform/gen/proto/common"
+	"live-stream-platform/pkg/logger"
+)
+
+// Domain ErrorInfo 中的错误域
+const Domain = "live-stream-platform"
+
+// metadataCode ErrorInfo.Metadata 中保存旧版数字错误码的键
+const metadataCode = "code"
+
+var kindCodes = map[Kind]codes.Code{
+	KindInternal:          codes.Internal,
+	KindInvalidArgument:   codes.InvalidArgument,
+	KindNotFound:          codes.NotFound,
+	KindAlreadyExists:     codes.AlreadyExists,
+	KindUnauthenticated:   codes.Unauthenticated,
+	KindPermissionDenied:  codes.PermissionDenied,
+	KindResourceExhausted: codes.ResourceExhausted,
+}
+
+// ToStatus 将错误转换为 gRPC 状态，附带 ErrorInfo（原因与数字错误码）和 BadRequest（字段校验）详情；
+// 另附一个 common.Response 详情，旧客户端不解析 ErrorInfo 也能从中读到原来响应里的 code/message。
+// 非领域错误一律视为内部错误，只记录日志不返回细节
+func ToStatus(err error) *status.Status {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return status.Convert(err)
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.New(codes.Canceled, "request canceled")
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.New(codes.DeadlineExceeded, "deadline exceeded")
+	}
+	e, ok := As(err)
+	if !ok {
+		e = Internal(err)
+	}
+	if e.Kind == KindInternal {
+		logger.Error(context.Background(), "Internal error", logger.Err(e))
+	}
+
+	st := status.New(kindCodes[e.Kind], e.Message)
+	details := []protoadapt.MessageV1{
+		&errdetails.ErrorInfo{
+			Reason:   e.Reason,
+			Domain:   Domain,
+			Metadata: map[string]string{metadataCode: strconv.Itoa(int(e.Code))},
+		},
+		&commonPb.Response{Code: e.Code, Message: e.Message},
+	}
+	if len(e.Fields) > 0 {
+		violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(e.Fields))
+		for _, f := range e.Fields {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: f.Field, Description: f.Description})
+		}
+		details = append(details, &errdetails.BadRequest{FieldViolations: violations})
+	}
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		return withDetails
+	}
+	return st
+}
+
+// GRPCError 将错误转换为可直接从 gRPC Handler 返回的错误
+func GRPCError(err error) error {
+	return ToStatus(err).Err()
+}
+
+// FromError 在调用方还原下游返回的领域错误；没有 ErrorInfo 时按 gRPC 状态码推断类别
+func FromError(err error) *Error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return Internal(err)
+	}
+	e := &Error{Kind: KindInternal, Code: CodeInternal, Reason: st.Code().String(), Message: st.Message(), cause: err}
+	for kind, code := range kindCodes {
+		if code == st.Code() {
+			e.Kind = kind
+			e.Code = defaultCode(kind)
+		}
+	}
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			e.Reason = d.Reason
+			if code, err := strconv.Atoi(d.Metadata[metadataCode]); err == nil {
+				e.Code = int32(code)
+			}
+		case *errdetails.BadRequest:
+			for _, v := range d.FieldViolations {
+				e.Fields = append(e.Fields, FieldViolation{Field: v.Field, Description: v.Description})
+			}
+		}
+	}
+	return e
+}
+
+func defaultCode(kind Kind) int32 {
+	switch kind {
+	case KindInvalidArgument:
+		return CodeInvalidArgument
+	case KindNotFound:
+		return CodeNotFound
+	case KindAlreadyExists:
+		return CodeAlreadyExists
+	case KindUnauthenticated:
+		return CodeUnauthenticated
+	case KindPermissionDenied:
+		return CodePermissionDenied
+	case KindResourceExhausted:
+		return CodeTooManyRequests
+	default:
+		return CodeInternal
+	}
+}
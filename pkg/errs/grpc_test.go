@@ -0,0 +1,126 @@
+package errs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	commonPb "live-stream-platform/gen/proto/common"
+)
+
+func TestStatusRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *Error
+		code codes.Code
+	}{
+		{"invalid argument", InvalidArgument(10001, "INVALID_PARAMS", "invalid username"), codes.InvalidArgument},
+		{"with fields", InvalidArgument(10001, "INVALID_PARAMS", "invalid params").WithField("email", "invalid email"), codes.InvalidArgument},
+		{"not found", NotFound(20002, "ROOM_NOT_FOUND", "room not found"), codes.NotFound},
+		{"already exists", AlreadyExists(30007, "IDEMPOTENCY_CONFLICT", "biz_no reused"), codes.AlreadyExists},
+		{"unauthenticated", Unauthenticated(CodeUnauthenticated, "UNAUTHENTICATED", "invalid token"), codes.Unauthenticated},
+		{"permission denied", PermissionDenied(60002, "PERMISSION_DENIED", "admin only"), codes.PermissionDenied},
+		{"resource exhausted", ResourceExhausted(CodeTooManyRequests, "EMAIL_COOLDOWN", "try again later"), codes.ResourceExhausted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := ToStatus(tt.err)
+			if st.Code() != tt.code {
+				t.Fatalf("code = %s, want %s", st.Code(), tt.code)
+			}
+			if st.Message() != tt.err.Message {
+				t.Errorf("message = %q, want %q", st.Message(), tt.err.Message)
+			}
+			var legacy *commonPb.Response
+			for _, d := range st.Details() {
+				if r, ok := d.(*commonPb.Response); ok {
+					legacy = r
+				}
+			}
+			if legacy == nil || legacy.Code != tt.err.Code || legacy.Message != tt.err.Message {
+				t.Errorf("legacy detail = %v, want code %d message %q", legacy, tt.err.Code, tt.err.Message)
+			}
+
+			got := FromError(st.Err())
+			if got.Kind != tt.err.Kind || got.Code != tt.err.Code || got.Reason != tt.err.Reason || got.Message != tt.err.Message {
+				t.Errorf("FromError = {%d %d %s %q}, want {%d %d %s %q}",
+					got.Kind, got.Code, got.Reason, got.Message, tt.err.Kind, tt.err.Code, tt.err.Reason, tt.err.Message)
+			}
+			if len(got.Fields) != len(tt.err.Fields) {
+				t.Fatalf("fields = %v, want %v", got.Fields, tt.err.Fields)
+			}
+			for i := range got.Fields {
+				if got.Fields[i] != tt.err.Fields[i] {
+					t.Errorf("field %d = %v, want %v", i, got.Fields[i], tt.err.Fields[i])
+				}
+			}
+			if !errors.Is(got, tt.err) {
+				t.Errorf("errors.Is(FromError(...), %s) = false", tt.err.Reason)
+			}
+		})
+	}
+}
+
+func TestToStatusHidesInternalErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"plain error", errors.New("dial tcp 10.0.0.1:3306: connection refused")},
+		{"wrapped", Wrap(errors.New("duplicate key"), "failed to create user")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := ToStatus(tt.err)
+			if st.Code() != codes.Internal || st.Message() != "internal error" {
+				t.Errorf("status = %s %q, want Internal %q", st.Code(), st.Message(), "internal error")
+			}
+			got := FromError(st.Err())
+			if got.Kind != KindInternal || got.Code != CodeInternal || got.Reason != "INTERNAL" {
+				t.Errorf("FromError = {%d %d %s}, want internal", got.Kind, got.Code, got.Reason)
+			}
+		})
+	}
+}
+
+func TestToStatusContextErrors(t *testing.T) {
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{context.Canceled, codes.Canceled},
+		{fmt.Errorf("query: %w", context.DeadlineExceeded), codes.DeadlineExceeded},
+		{status.Error(codes.Unavailable, "upstream down"), codes.Unavailable},
+	}
+	for _, tt := range tests {
+		if got := ToStatus(tt.err).Code(); got != tt.code {
+			t.Errorf("ToStatus(%v) code = %s, want %s", tt.err, got, tt.code)
+		}
+	}
+}
+
+func TestFromErrorWithoutDetails(t *testing.T) {
+	tests := []struct {
+		err  error
+		kind Kind
+		code int32
+	}{
+		{status.Error(codes.NotFound, "not found"), KindNotFound, CodeNotFound},
+		{status.Error(codes.PermissionDenied, "denied"), KindPermissionDenied, CodePermissionDenied},
+		{status.Error(codes.ResourceExhausted, "slow down"), KindResourceExhausted, CodeTooManyRequests},
+		{status.Error(codes.Unavailable, "upstream down"), KindInternal, CodeInternal},
+		{errors.New("not a status"), KindInternal, CodeInternal},
+	}
+	for _, tt := range tests {
+		got := FromError(tt.err)
+		if got.Kind != tt.kind || got.Code != tt.code {
+			t.Errorf("FromError(%v) = {%d %d}, want {%d %d}", tt.err, got.Kind, got.Code, tt.kind, tt.code)
+		}
+	}
+	if FromError(nil) != nil {
+		t.Error("FromError(nil) != nil")
+	}
+}
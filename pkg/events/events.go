@@ -0,0 +1,183 @@
+// Package events 平台领域事件：统一的信封、事件类型注册表，以及发布与消费的辅助函数。
+// 事件类型同时作为 RabbitMQ 路由键，负载为注册表中登记的 protobuf 消息。
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+	commonPb "live-stream-platform/gen/proto/common"
+	"live-stream-platform/pkg/requestid"
+)
+
+// 信封的编码方式，即 AMQP 消息的 content-type
+const (
+	ContentTypeProtobuf = "application/protobuf"
+	ContentTypeJSON     = "application/json"
+)
+
+var (
+	// ErrUnknownType 事件类型未注册
+	ErrUnknownType = errors.New("events: unknown event type")
+	// ErrInvalidEnvelope 信封缺少必填字段或负载与类型不符
+	ErrInvalidEnvelope = errors.New("events: invalid envelope")
+)
+
+// Schema 事件类型与负载消息的对应关系
+type Schema struct {
+	Type    string
+	Version int32
+	message protoreflect.MessageType
+}
+
+var registry = struct {
+	sync.RWMutex
+	byType    map[string]*Schema
+	byMessage map[protoreflect.FullName]*Schema
+}{
+	byType:    make(map[string]*Schema),
+	byMessage: make(map[protoreflect.FullName]*Schema),
+}
+
+// Register 登记事件类型及其负载消息，每种负载消息只能对应一个事件类型。
+// 负载做不兼容修改（删除或改变字段含义）时递增 version，兼容的新增字段不需要
+func Register(eventType string, version int32, payload proto.Message) {
+	if eventType == "" || version < 1 {
+		panic(fmt.Sprintf("events: invalid registration %q v%d", eventType, version))
+	}
+	mt := payload.ProtoReflect().Type()
+	registry.Lock()
+	defer registry.Unlock()
+	if _, ok := registry.byType[eventType]; ok {
+		panic("events: duplicate event type " + eventType)
+	}
+	if s, ok := registry.byMessage[mt.Descriptor().FullName()]; ok {
+		panic(fmt.Sprintf("events: %s is already registered as %s", mt.Descriptor().FullName(), s.Type))
+	}
+	s := &Schema{Type: eventType, Version: version, message: mt}
+	registry.byType[eventType] = s
+	registry.byMessage[mt.Descriptor().FullName()] = s
+}
+
+// Lookup 按事件类型查找 Schema
+func Lookup(eventType string) (*Schema, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	s, ok := registry.byType[eventType]
+	return s, ok
+}
+
+// schemaOf 按负载消息查找 Schema
+func schemaOf(payload proto.Message) (*Schema, error) {
+	name := payload.ProtoReflect().Descriptor().FullName()
+	registry.RLock()
+	defer registry.RUnlock()
+	s, ok := registry.byMessage[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: no event type for %s", ErrUnknownType, name)
+	}
+	return s, nil
+}
+
+// New 为负载创建信封：生成事件 ID、填入类型与版本，并记录 ctx 中的 trace-context
+func New(ctx context.Context, producer string, payload proto.Message) (*commonPb.EventEnvelope, error) {
+	s, err := schemaOf(payload)
+	if err != nil {
+		return nil, err
+	}
+	body, err := anypb.New(payload)
+	if err != nil {
+		return nil, fmt.Errorf("events: pack payload: %w", err)
+	}
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	return &commonPb.EventEnvelope{
+		EventId:      requestid.New(),
+		Type:         s.Type,
+		Version:      s.Version,
+		OccurredAt:   time.Now().UnixMilli(),
+		Producer:     producer,
+		TraceContext: carrier,
+		Payload:      body,
+	}, nil
+}
+
+// Validate 校验信封必填字段，并确认负载是该事件类型登记的消息
+func Validate(env *commonPb.EventEnvelope) error {
+	switch {
+	case env.GetEventId() == "":
+		return fmt.Errorf("%w: missing event_id", ErrInvalidEnvelope)
+	case env.GetType() == "":
+		return fmt.Errorf("%w: missing type", ErrInvalidEnvelope)
+	case env.GetVersion() < 1:
+		return fmt.Errorf("%w: invalid version %d", ErrInvalidEnvelope, env.GetVersion())
+	case env.GetOccurredAt() <= 0:
+		return fmt.Errorf("%w: missing occurred_at", ErrInvalidEnvelope)
+	case env.GetProducer() == "":
+		return fmt.Errorf("%w: missing producer", ErrInvalidEnvelope)
+	case env.GetPayload() == nil:
+		return fmt.Errorf("%w: missing payload", ErrInvalidEnvelope)
+	}
+	s, ok := Lookup(env.Type)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownType, env.Type)
+	}
+	if got := env.Payload.MessageName(); got != s.message.Descriptor().FullName() {
+		return fmt.Errorf("%w: %s carries %s, want %s", ErrInvalidEnvelope, env.Type, got, s.message.Descriptor().FullName())
+	}
+	return nil
+}
+
+// Marshal 按 contentType 编码信封
+func Marshal(env *commonPb.EventEnvelope, contentType string) ([]byte, error) {
+	switch contentType {
+	case ContentTypeProtobuf:
+		return proto.Marshal(env)
+	case ContentTypeJSON:
+		return protojson.Marshal(env)
+	default:
+		return nil, fmt.Errorf("events: unsupported content type %q", contentType)
+	}
+}
+
+// Event 解码并校验后的事件
+type Event struct {
+	Envelope *commonPb.EventEnvelope
+	Payload  proto.Message
+}
+
+// Unmarshal 按 contentType 解码信封，校验后解出负载
+func Unmarshal(body []byte, contentType string) (*Event, error) {
+	env := &commonPb.EventEnvelope{}
+	var err error
+	switch contentType {
+	case ContentTypeProtobuf:
+		err = proto.Unmarshal(body, env)
+	case ContentTypeJSON:
+		// 忽略未知字段，生产方可以先于消费方升级
+		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(body, env)
+	default:
+		return nil, fmt.Errorf("events: unsupported content type %q", contentType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEnvelope, err)
+	}
+	if err := Validate(env); err != nil {
+		return nil, err
+	}
+	s, _ := Lookup(env.Type)
+	payload := s.message.New().Interface()
+	if err := env.Payload.UnmarshalTo(payload); err != nil {
+		return nil, fmt.Errorf("%w: decode %s payload: %v", ErrInvalidEnvelope, env.Type, err)
+	}
+	return &Event{Envelope: env, Payload: payload}, nil
+}
@@ -0,0 +1,99 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	commonPb "live-stream-platform/gen/proto/common"
+	"live-stream-platform/pkg/rabbitmq"
+)
+
+// 进程内的发布配置，由 Init 设置
+var (
+	producer    string
+	contentType = ContentTypeProtobuf
+)
+
+// Init 设置发布方名称和信封编码方式，contentType 为空时使用 protobuf
+func Init(name, ct string) error {
+	if name == "" {
+		return errors.New("events: producer is required")
+	}
+	if ct == "" {
+		ct = ContentTypeProtobuf
+	}
+	if ct != ContentTypeProtobuf && ct != ContentTypeJSON {
+		return fmt.Errorf("events: unsupported content type %q", ct)
+	}
+	producer, contentType = name, ct
+	return nil
+}
+
+// Publish 把负载包装为信封，以事件类型为路由键发布并等待 broker 确认。
+// 与数据库写入需要保持一致的事件应通过 outbox 发布
+func Publish(ctx context.Context, payload proto.Message) error {
+	msg, err := NewMessage(ctx, payload)
+	if err != nil {
+		return err
+	}
+	return rabbitmq.PublishMessage(ctx, msg.Type, msg)
+}
+
+// NewMessage 使用 Init 设置的发布方与编码方式，把负载包装为信封并编码为 AMQP 消息，消息的 Type 即路由键
+func NewMessage(ctx context.Context, payload proto.Message) (amqp.Publishing, error) {
+	if producer == "" {
+		return amqp.Publishing{}, errors.New("events: not initialized")
+	}
+	env, err := New(ctx, producer, payload)
+	if err != nil {
+		return amqp.Publishing{}, err
+	}
+	return Encode(env, contentType)
+}
+
+// Encode 把信封编码为 AMQP 消息，消息 ID 与事件 ID 一致，便于消费方去重
+func Encode(env *commonPb.EventEnvelope, ct string) (amqp.Publishing, error) {
+	body, err := Marshal(env, ct)
+	if err != nil {
+		return amqp.Publishing{}, err
+	}
+	return amqp.Publishing{
+		ContentType: ct,
+		Type:        env.Type,
+		MessageId:   env.EventId,
+		AppId:       env.Producer,
+		Timestamp:   time.UnixMilli(env.OccurredAt),
+		Body:        body,
+	}, nil
+}
+
+// Handle 把事件处理函数适配为 rabbitmq.Handler：解码并校验信封，负载类型必须是 T。
+// 无法解码或校验失败的消息重试也不会成功，直接进入死信队列
+func Handle[T proto.Message](fn func(ctx context.Context, env *commonPb.EventEnvelope, payload T) error) rabbitmq.Handler {
+	return func(ctx context.Context, msg *rabbitmq.Message) error {
+		ct := msg.ContentType
+		if ct == "" {
+			ct = ContentTypeProtobuf
+		}
+		event, err := Unmarshal(msg.Body, ct)
+		if err != nil {
+			return rabbitmq.Permanent(fmt.Errorf("decode %s: %w", msg.RoutingKey, err))
+		}
+		payload, ok := event.Payload.(T)
+		if !ok {
+			return rabbitmq.Permanent(fmt.Errorf("%w: %s payload is %T", ErrInvalidEnvelope, event.Envelope.Type, event.Payload))
+		}
+		// 消息头没有携带 trace-context 时（如经由其他通道转发），使用信封中记录的
+		if !trace.SpanContextFromContext(ctx).IsValid() && len(event.Envelope.TraceContext) > 0 {
+			ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(event.Envelope.TraceContext))
+		}
+		return fn(ctx, event.Envelope, payload)
+	}
+}
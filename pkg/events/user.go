@@ -0,0 +1,24 @@
+package events
+
+import (
+	userPb "live-stream-platform/gen/proto/user"
+)
+
+// UserService 发布的事件
+const (
+	TypeUserRegistered = "user.registered"
+	TypeUserUpdated    = "user.updated"
+	TypeUserBanned     = "user.banned"
+	TypeUserFollowed   = "user.followed"
+	TypeUserUnfollowed = "user.unfollowed"
+	TypeUserLoggedIn   = "user.logged_in"
+)
+
+func init() {
+	Register(TypeUserRegistered, 1, &userPb.UserRegistered{})
+	Register(TypeUserUpdated, 1, &userPb.UserUpdated{})
+	Register(TypeUserBanned, 1, &userPb.UserBanned{})
+	Register(TypeUserFollowed, 1, &userPb.UserFollowed{})
+	Register(TypeUserUnfollowed, 1, &userPb.UserUnfollowed{})
+	Register(TypeUserLoggedIn, 1, &userPb.UserLoggedIn{})
+}
@@ -0,0 +1,125 @@
+package grpcx
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"live-stream-platform/pkg/errs"
+	"live-stream-platform/pkg/jwt"
+)
+
+// authorizationKey 携带 Bearer Token 的 metadata 键
+const authorizationKey = "authorization"
+
+var (
+	errUnauthenticated  = errs.Unauthenticated(errs.CodeUnauthenticated, "UNAUTHENTICATED", "missing or invalid bearer token")
+	errPermissionDenied = errs.PermissionDenied(errs.CodePermissionDenied, "PERMISSION_DENIED", "permission denied")
+	errServiceOnly      = errs.PermissionDenied(errs.CodePermissionDenied, "SERVICE_ONLY", "method is only available to internal services")
+)
+
+// Authenticator 校验用户令牌，返回令牌声明
+type Authenticator func(ctx context.Context, token string) (*jwt.Claims, error)
+
+// ParseToken 只校验签名与有效期的默认 Authenticator
+func ParseToken(_ context.Context, token string) (*jwt.Claims, error) {
+	return jwt.ParseToken(token)
+}
+
+type claimsKey struct{}
+
+// ClaimsFromContext 获取已认证调用方的令牌声明，公开方法中不存在
+func ClaimsFromContext(ctx context.Context) (*jwt.Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*jwt.Claims)
+	return claims, ok && claims != nil
+}
+
+// RequireUser 要求调用方就是 userID 本人。服务令牌代表已自行鉴权的内部服务，直接放行
+func RequireUser(ctx context.Context, userID int64) error {
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return errUnauthenticated
+	}
+	if claims.IsService() || claims.UserID == userID {
+		return nil
+	}
+	return errPermissionDenied
+}
+
+// RequireService 要求调用方持有服务令牌，用于内部与特权接口
+func RequireService(ctx context.Context) error {
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return errUnauthenticated
+	}
+	if !claims.IsService() {
+		return errServiceOnly
+	}
+	return nil
+}
+
+// UnaryAuth JWT 鉴权，publicMethods 中的方法跳过
+func UnaryAuth(authenticator Authenticator, publicMethods map[string]bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if publicMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		ctx, err := authenticate(ctx, authenticator)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuth 流式接口的 JWT 鉴权
+func StreamAuth(authenticator Authenticator, publicMethods map[string]bool) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if publicMethods[info.FullMethod] {
+			return handler(srv, ss)
+		}
+		ctx, err := authenticate(ss.Context(), authenticator)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticate 服务令牌只校验签名，用户令牌交给 authenticator（如检查会话是否被吊销）
+func authenticate(ctx context.Context, authenticator Authenticator) (context.Context, error) {
+	token := bearerToken(ctx)
+	if token == "" {
+		return nil, errs.GRPCError(errUnauthenticated)
+	}
+	claims, err := jwt.ParseToken(token)
+	if err != nil {
+		return nil, errs.GRPCError(errUnauthenticated)
+	}
+	if !claims.IsService() {
+		if claims, err = authenticator(ctx, token); err != nil {
+			if _, ok := errs.As(err); !ok {
+				err = errUnauthenticated
+			}
+			return nil, errs.GRPCError(err)
+		}
+	}
+	return context.WithValue(ctx, claimsKey{}, claims), nil
+}
+
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(authorizationKey)
+	if len(values) == 0 {
+		return ""
+	}
+	auth := values[0]
+	if len(auth) > 7 && strings.EqualFold(auth[:7], "Bearer ") {
+		return strings.TrimSpace(auth[7:])
+	}
+	return ""
+}
@@ -0,0 +1,144 @@
+package grpcx
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"live-stream-platform/pkg/jwt"
+	"live-stream-platform/pkg/requestid"
+)
+
+const (
+	// serviceTokenTTL 服务令牌有效期
+	serviceTokenTTL = 10 * time.Minute
+	// serviceTokenRenewBefore 服务令牌提前续签的时间
+	serviceTokenRenewBefore = time.Minute
+)
+
+type clientOptions struct {
+	serviceTokens *serviceTokenSource
+	// serviceOnly 不转发用户令牌，始终使用服务令牌
+	serviceOnly bool
+	dialOptions []grpc.DialOption
+}
+
+// ClientOption NewClient 选项
+type ClientOption func(*clientOptions)
+
+// WithServiceIdentity 没有可转发的用户令牌时（如定时任务），以服务身份调用下游
+func WithServiceIdentity(service string) ClientOption {
+	return func(o *clientOptions) {
+		o.serviceTokens = &serviceTokenSource{service: service}
+	}
+}
+
+// AsService 始终以服务身份调用下游，不转发用户令牌。
+// 用于调用方已自行完成鉴权、需要调用下游特权接口的场景（如管理后台）
+func AsService(service string) ClientOption {
+	return func(o *clientOptions) {
+		o.serviceTokens = &serviceTokenSource{service: service}
+		o.serviceOnly = true
+	}
+}
+
+// WithDialOptions 追加原生 gRPC 选项
+func WithDialOptions(opts ...grpc.DialOption) ClientOption {
+	return func(o *clientOptions) {
+		o.dialOptions = append(o.dialOptions, opts...)
+	}
+}
+
+// NewClient 创建下游服务连接，自动透传请求 ID、trace-context 与调用方令牌
+func NewClient(target string, opts ...ClientOption) (*grpc.ClientConn, error) {
+	o := &clientOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithChainUnaryInterceptor(unaryClientPropagation(o)),
+		grpc.WithChainStreamInterceptor(streamClientPropagation(o)),
+	}
+	return grpc.NewClient(target, append(dialOpts, o.dialOptions...)...)
+}
+
+type tokenKey struct{}
+
+// ContextWithToken 指定下游调用携带的用户令牌，网关鉴权通过后使用
+func ContextWithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenKey{}, token)
+}
+
+func unaryClientPropagation(o *clientOptions) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, err := outgoingContext(ctx, o)
+		if err != nil {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func streamClientPropagation(o *clientOptions) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, err := outgoingContext(ctx, o)
+		if err != nil {
+			return nil, err
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+// outgoingContext 令牌优先级：显式指定的用户令牌 > 当前请求携带的令牌 > 服务令牌，AsService 时只用服务令牌
+func outgoingContext(ctx context.Context, o *clientOptions) (context.Context, error) {
+	if id := requestid.FromContext(ctx); id != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, requestid.MetadataKey, id)
+	}
+	var token string
+	if !o.serviceOnly {
+		token, _ = ctx.Value(tokenKey{}).(string)
+		if token == "" {
+			token = bearerToken(ctx)
+		}
+	}
+	if token == "" && o.serviceTokens != nil {
+		var err error
+		if token, err = o.serviceTokens.Token(); err != nil {
+			return nil, err
+		}
+	}
+	if token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, authorizationKey, "Bearer "+token)
+	}
+	return ctx, nil
+}
+
+// serviceTokenSource 缓存并按需续签服务令牌
+type serviceTokenSource struct {
+	service string
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+func (s *serviceTokenSource) Token() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != "" && time.Until(s.expiresAt) > serviceTokenRenewBefore {
+		return s.token, nil
+	}
+	token, claims, err := jwt.GenerateServiceToken(s.service, serviceTokenTTL)
+	if err != nil {
+		return "", err
+	}
+	s.token = token
+	s.expiresAt = claims.ExpiresAt.Time
+	return token, nil
+}
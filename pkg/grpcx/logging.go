@@ -0,0 +1,42 @@
+package grpcx
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"live-stream-platform/pkg/logger"
+)
+
+// UnaryLogging 访问日志：方法、状态码、耗时、对端地址、请求 ID 与 trace ID
+func UnaryLogging(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	logAccess(ctx, info.FullMethod, start, err)
+	return resp, err
+}
+
+// StreamLogging 流式接口的访问日志，流结束时输出
+func StreamLogging(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	logAccess(ss.Context(), info.FullMethod, start, err)
+	return err
+}
+
+func logAccess(ctx context.Context, method string, start time.Time, err error) {
+	peerAddr := "-"
+	if p, ok := peer.FromContext(ctx); ok {
+		peerAddr = p.Addr.String()
+	}
+	// 请求 ID 与 trace ID 由 logger 从上下文中提取
+	logger.Info(ctx, "gRPC request",
+		slog.String("method", method),
+		slog.String("code", status.Code(err).String()),
+		slog.Duration("latency", time.Since(start)),
+		slog.String("peer", peerAddr),
+	)
+}
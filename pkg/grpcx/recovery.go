@@ -0,0 +1,38 @@
+package grpcx
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"live-stream-platform/pkg/logger"
+)
+
+// UnaryRecovery 捕获 panic 并返回 Internal，避免单个请求导致进程退出
+func UnaryRecovery(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(ctx, info.FullMethod, r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// StreamRecovery 流式接口的 panic 恢复
+func StreamRecovery(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(ss.Context(), info.FullMethod, r)
+		}
+	}()
+	return handler(srv, ss)
+}
+
+func recovered(ctx context.Context, method string, r any) error {
+	logger.Error(ctx, "Panic recovered", slog.String("method", method), slog.Any("panic", r),
+		slog.String("stack", string(debug.Stack())))
+	return status.Error(codes.Internal, "internal error")
+}
@@ -0,0 +1,42 @@
+package grpcx
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"live-stream-platform/pkg/requestid"
+)
+
+// UnaryRequestID 从 metadata 读取请求 ID（缺失时生成），写入上下文并回传给调用方
+func UnaryRequestID(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx = withRequestID(ctx)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestid.MetadataKey, requestid.FromContext(ctx)))
+	return handler(ctx, req)
+}
+
+// StreamRequestID 流式接口的请求 ID
+func StreamRequestID(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := withRequestID(ss.Context())
+	_ = ss.SetHeader(metadata.Pairs(requestid.MetadataKey, requestid.FromContext(ctx)))
+	return handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+}
+
+func withRequestID(ctx context.Context) context.Context {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestid.MetadataKey); len(values) > 0 && values[0] != "" {
+			return requestid.NewContext(ctx, values[0])
+		}
+	}
+	return requestid.NewContext(ctx, requestid.New())
+}
+
+// wrappedStream 替换 ServerStream 的上下文
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (w *wrappedStream) Context() context.Context {
+	return w.ctx
+}
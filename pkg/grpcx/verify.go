@@ -0,0 +1,92 @@
+package grpcx
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	userPb "live-stream-platform/gen/proto/user"
+	"live-stream-platform/pkg/errs"
+	"live-stream-platform/pkg/jwt"
+)
+
+const (
+	// DefaultVerifyCacheTTL 会话校验结果的默认缓存时长，登出或封禁最多延迟这么久生效
+	DefaultVerifyCacheTTL = 10 * time.Second
+	// maxVerifyCacheEntries 缓存条目上限，超出时先清理过期条目，仍超出则整体清空
+	maxVerifyCacheEntries = 10000
+)
+
+// VerifyToken 通过用户服务的 VerifyToken 校验用户令牌对应的会话仍然有效（未登出、未被封禁吊销），
+// 校验通过的结果按令牌缓存 ttl，ttl 不超过令牌本身的有效期
+func VerifyToken(client userPb.UserServiceClient, ttl time.Duration) Authenticator {
+	cache := &verifyCache{ttl: ttl, entries: make(map[string]verifyCacheEntry)}
+	return func(ctx context.Context, token string) (*jwt.Claims, error) {
+		if claims, ok := cache.get(token); ok {
+			return claims, nil
+		}
+		if _, err := client.VerifyToken(ctx, &userPb.VerifyTokenRequest{Token: token}); err != nil {
+			// 用户服务不可用时拒绝请求，且不向调用方暴露下游错误信息
+			if e := errs.FromError(err); e.Kind != errs.KindInternal {
+				return nil, e
+			}
+			return nil, errs.Wrap(err, "failed to verify token")
+		}
+		claims, err := jwt.ParseToken(token)
+		if err != nil {
+			return nil, err
+		}
+		cache.put(token, claims)
+		return claims, nil
+	}
+}
+
+type verifyCacheEntry struct {
+	claims   *jwt.Claims
+	expireAt time.Time
+}
+
+// verifyCache 只缓存校验通过的令牌
+type verifyCache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]verifyCacheEntry
+}
+
+func (c *verifyCache) get(token string) (*jwt.Claims, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[token]
+	if !ok {
+		return nil, false
+	}
+	if !time.Now().Before(entry.expireAt) {
+		delete(c.entries, token)
+		return nil, false
+	}
+	return entry.claims, true
+}
+
+func (c *verifyCache) put(token string, claims *jwt.Claims) {
+	if c.ttl <= 0 {
+		return
+	}
+	now := time.Now()
+	expireAt := now.Add(c.ttl)
+	if claims.ExpiresAt != nil && claims.ExpiresAt.Before(expireAt) {
+		expireAt = claims.ExpiresAt.Time
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= maxVerifyCacheEntries {
+		for k, entry := range c.entries {
+			if !now.Before(entry.expireAt) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= maxVerifyCacheEntries {
+			c.entries = make(map[string]verifyCacheEntry)
+		}
+	}
+	c.entries[token] = verifyCacheEntry{claims: claims, expireAt: expireAt}
+}
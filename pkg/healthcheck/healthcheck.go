@@ -0,0 +1,199 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	commonPb "live-stream-platform/gen/proto/common"
+	"live-stream-platform/pkg/logger"
+)
+
+const (
+	// DefaultInterval 默认检查间隔
+	DefaultInterval = 5 * time.Second
+	// DefaultDrainDelay 切换为 NOT_SERVING 后等待负载均衡摘除的时间
+	DefaultDrainDelay = 5 * time.Second
+	// checkTimeout 单个依赖检查的超时时间
+	checkTimeout = 2 * time.Second
+)
+
+// 依赖状态
+const (
+	StatusOK          = "ok"
+	StatusUnavailable = "unavailable"
+)
+
+// Check 依赖检查函数，返回 nil 表示依赖可用
+type Check func(ctx context.Context) error
+
+type dependency struct {
+	name  string
+	check Check
+	err   error
+}
+
+// Checker 周期性检查依赖，并据此驱动标准 grpc.health.v1 服务的状态
+type Checker struct {
+	server   *health.Server
+	services []string
+	interval time.Duration
+
+	mu           sync.RWMutex
+	dependencies []*dependency
+	checked      bool
+}
+
+// New 创建 Checker，services 为需要上报状态的完整服务名（如 user.UserService），空串代表整体状态始终上报
+func New(interval time.Duration, services ...string) *Checker {
+	c := &Checker{
+		server:   health.NewServer(),
+		services: append([]string{""}, services...),
+		interval: interval,
+	}
+	// 首次检查完成前不接收流量
+	c.setServing(healthpb.HealthCheckResponse_NOT_SERVING)
+	return c
+}
+
+// Add 添加依赖检查，需在 Run 之前调用
+func (c *Checker) Add(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dependencies = append(c.dependencies, &dependency{name: name, check: check})
+}
+
+// Register 在 gRPC 服务器上注册 grpc.health.v1 服务
+func (c *Checker) Register(s *grpc.Server) {
+	healthpb.RegisterHealthServer(s, c.server)
+}
+
+// Run 立即检查一次，之后按间隔检查，直到 ctx 取消
+func (c *Checker) Run(ctx context.Context) {
+	c.checkAll(ctx)
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.checkAll(ctx)
+		}
+	}
+}
+
+// Shutdown 切换为 NOT_SERVING 并忽略后续检查结果，在 GracefulStop 前调用，让负载均衡先摘除本实例
+func (c *Checker) Shutdown() {
+	c.server.Shutdown()
+}
+
+// Drain 切换为 NOT_SERVING 并等待 delay，之后再停止 gRPC 服务器
+func (c *Checker) Drain(delay time.Duration) {
+	c.Shutdown()
+	logger.Info(context.Background(), "Health status set to NOT_SERVING, draining", slog.Duration("delay", delay))
+	time.Sleep(delay)
+}
+
+// Healthy 所有依赖是否可用
+func (c *Checker) Healthy() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if !c.checked {
+		return false
+	}
+	for _, dep := range c.dependencies {
+		if dep.err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Dependencies 各依赖最近一次的检查结果。Health 接口是公开的，只返回概括的原因，
+// 原始错误（可能包含地址、账号等）只在状态变化时记录到日志
+func (c *Checker) Dependencies() []*commonPb.DependencyStatus {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	result := make([]*commonPb.DependencyStatus, 0, len(c.dependencies))
+	for _, dep := range c.dependencies {
+		status := &commonPb.DependencyStatus{Name: dep.name, Status: StatusOK}
+		if !c.checked {
+			status.Status = StatusUnavailable
+			status.Error = "not checked yet"
+		} else if dep.err != nil {
+			status.Status = StatusUnavailable
+			status.Error = reason(dep.err)
+		}
+		result = append(result, status)
+	}
+	return result
+}
+
+// Status 整体状态
+func (c *Checker) Status() string {
+	if c.Healthy() {
+		return StatusOK
+	}
+	return StatusUnavailable
+}
+
+// reason 对外展示的失败原因
+func reason(err error) string {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "check timed out"
+	}
+	return "check failed"
+}
+
+func (c *Checker) checkAll(ctx context.Context) {
+	c.mu.RLock()
+	deps := append([]*dependency(nil), c.dependencies...)
+	c.mu.RUnlock()
+
+	errs := make([]error, len(deps))
+	var wg sync.WaitGroup
+	for i, dep := range deps {
+		wg.Add(1)
+		go func(i int, dep *dependency) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+			defer cancel()
+			errs[i] = dep.check(checkCtx)
+		}(i, dep)
+	}
+	wg.Wait()
+
+	c.mu.Lock()
+	healthy := true
+	for i, dep := range deps {
+		if errs[i] != nil && dep.err == nil {
+			logger.Warn(ctx, "Dependency unavailable", slog.String("dependency", dep.name), logger.Err(errs[i]))
+		} else if errs[i] == nil && dep.err != nil && c.checked {
+			logger.Info(ctx, "Dependency recovered", slog.String("dependency", dep.name))
+		}
+		dep.err = errs[i]
+		if errs[i] != nil {
+			healthy = false
+		}
+	}
+	c.checked = true
+	c.mu.Unlock()
+
+	if healthy {
+		c.setServing(healthpb.HealthCheckResponse_SERVING)
+	} else {
+		c.setServing(healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+}
+
+func (c *Checker) setServing(status healthpb.HealthCheckResponse_ServingStatus) {
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, status)
+	}
+}
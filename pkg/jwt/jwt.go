@@ -3,12 +3,16 @@ package jwt
 import (
 	"errors"
 	"github.com/golang-jwt/jwt/v5"
+	"live-stream-platform/pkg/utils"
+	"strings"
 	"time"
 )
 
 type Claims struct {
 	UserID   int64  `json:"user_id"`
 	Username string `json:"username"`
+	// SessionID 令牌所属的登录会话，会话被吊销后其下所有令牌失效
+	SessionID string `json:"sid,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -18,14 +22,21 @@ func Init(secret string) {
 	jwtSecret = []byte(secret)
 }
 
-func GenerateToken(userID int64, username string, expireHours int) (string, error) {
+// GenerateToken 签发短期访问令牌，每个令牌带唯一 jti
+func GenerateToken(userID int64, username, sessionID string, expire time.Duration) (string, *Claims, error) {
+	jti, err := utils.GenerateRandomString(32)
+	if err != nil {
+		return "", nil, err
+	}
 	nowTime := time.Now()
-	expireTime := nowTime.Add(time.Duration(expireHours) * time.Hour)
+	expireTime := nowTime.Add(expire)
 
-	claims := Claims{
-		UserID:   userID,
-		Username: username,
+	claims := &Claims{
+		UserID:    userID,
+		Username:  username,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
 			ExpiresAt: jwt.NewNumericDate(expireTime),
 			IssuedAt:  jwt.NewNumericDate(nowTime),
 			NotBefore: jwt.NewNumericDate(nowTime),
@@ -34,15 +45,15 @@ func GenerateToken(userID int64, username string, expireHours int) (string, erro
 	tokenClaims := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	token, err := tokenClaims.SignedString(jwtSecret)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
-	return token, nil
+	return token, claims, nil
 }
 
 func ParseToken(tokenString string) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
 		return jwtSecret, nil
-	})
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
 	if err != nil {
 		return nil, err
 	}
@@ -52,10 +63,29 @@ func ParseToken(tokenString string) (*Claims, error) {
 	return nil, errors.New("invalid token")
 }
 
-func RefreshToken(tokenString string, expireHours int) (string, error) {
-	claims, err := ParseToken(tokenString)
+// serviceSubjectPrefix 服务间调用令牌的 subject 前缀
+const serviceSubjectPrefix = "service:"
+
+// GenerateServiceToken 签发服务间调用令牌，不绑定用户与会话
+func GenerateServiceToken(service string, expire time.Duration) (string, *Claims, error) {
+	nowTime := time.Now()
+	claims := &Claims{
+		Username: service,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   serviceSubjectPrefix + service,
+			ExpiresAt: jwt.NewNumericDate(nowTime.Add(expire)),
+			IssuedAt:  jwt.NewNumericDate(nowTime),
+			NotBefore: jwt.NewNumericDate(nowTime),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(jwtSecret)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
-	return GenerateToken(claims.UserID, claims.Username, expireHours)
+	return token, claims, nil
+}
+
+// IsService 是否为服务间调用令牌
+func (c *Claims) IsService() bool {
+	return strings.HasPrefix(c.Subject, serviceSubjectPrefix)
 }
@@ -0,0 +1,78 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// GormLogger 将 GORM 日志转到 slog：错误为 error，慢查询为 warn，其余语句为 debug。
+// SQL 只记录占位符，不记录参数值，避免密码哈希、邮箱等写入日志
+type GormLogger struct {
+	slowThreshold time.Duration
+	level         gormlogger.LogLevel
+}
+
+// NewGormLogger 创建 GORM 日志适配器，slowThreshold 为慢查询阈值
+func NewGormLogger(slowThreshold time.Duration) *GormLogger {
+	return &GormLogger{
+		slowThreshold: slowThreshold,
+		level:         gormlogger.Info,
+	}
+}
+
+// LogMode 设置 GORM 日志级别
+func (l *GormLogger) LogMode(level gormlogger.LogLevel) gormlogger.Interface {
+	clone := *l
+	clone.level = level
+	return &clone
+}
+
+func (l *GormLogger) Info(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= gormlogger.Info {
+		Info(ctx, fmt.Sprintf(msg, args...))
+	}
+}
+
+func (l *GormLogger) Warn(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= gormlogger.Warn {
+		Warn(ctx, fmt.Sprintf(msg, args...))
+	}
+}
+
+func (l *GormLogger) Error(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= gormlogger.Error {
+		Error(ctx, fmt.Sprintf(msg, args...))
+	}
+}
+
+// Trace 记录一条 SQL 语句
+func (l *GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	if l.level <= gormlogger.Silent {
+		return
+	}
+	elapsed := time.Since(begin)
+	switch {
+	case err != nil && !errors.Is(err, gorm.ErrRecordNotFound) && l.level >= gormlogger.Error:
+		sql, rows := fc()
+		Error(ctx, "SQL failed", slog.String("sql", sql), slog.Int64("rows", rows),
+			slog.Duration("elapsed", elapsed), Err(err))
+	case l.slowThreshold > 0 && elapsed > l.slowThreshold && l.level >= gormlogger.Warn:
+		sql, rows := fc()
+		Warn(ctx, "Slow SQL", slog.String("sql", sql), slog.Int64("rows", rows),
+			slog.Duration("elapsed", elapsed), slog.Duration("threshold", l.slowThreshold))
+	case l.level >= gormlogger.Info && slog.Default().Enabled(ctx, slog.LevelDebug):
+		sql, rows := fc()
+		Debug(ctx, "SQL", slog.String("sql", sql), slog.Int64("rows", rows), slog.Duration("elapsed", elapsed))
+	}
+}
+
+// ParamsFilter 不把参数值拼进 SQL
+func (l *GormLogger) ParamsFilter(_ context.Context, sql string, _ ...interface{}) (string, []interface{}) {
+	return sql, nil
+}
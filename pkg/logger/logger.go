@@ -0,0 +1,132 @@
+package logger
+
+import (
+	"context"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+	"live-stream-platform/pkg/config"
+	"live-stream-platform/pkg/requestid"
+)
+
+// 输出格式
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// level 全局 logger 的级别，可在运行时调整
+var level = new(slog.LevelVar)
+
+// Init 初始化全局 logger：生产环境默认输出 JSON，日志自动带上服务名、请求 ID 与 trace ID，
+// 敏感字段脱敏。标准库 log 的输出也会转到 slog
+func Init(service string, cfg *config.LogConfig) {
+	level.Set(ParseLevel(cfg.Level))
+	slog.SetDefault(newLogger(os.Stdout, cfg.Format, level).With("service", service))
+}
+
+// SetLevel 调整全局 logger 的级别，用于配置热更新
+func SetLevel(l string) {
+	level.Set(ParseLevel(l))
+}
+
+// New 创建 logger，输出到 w
+func New(w io.Writer, cfg *config.LogConfig) *slog.Logger {
+	return newLogger(w, cfg.Format, ParseLevel(cfg.Level))
+}
+
+func newLogger(w io.Writer, format string, level slog.Leveler) *slog.Logger {
+	opts := &slog.HandlerOptions{
+		Level:       level,
+		ReplaceAttr: redact,
+	}
+	var handler slog.Handler
+	if format == FormatJSON {
+		handler = slog.NewJSONHandler(w, opts)
+	} else {
+		handler = slog.NewTextHandler(w, opts)
+	}
+	return slog.New(&contextHandler{Handler: handler})
+}
+
+// ParseLevel 解析日志级别，无法识别时为 info
+func ParseLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// Debug 调试日志
+func Debug(ctx context.Context, msg string, args ...any) {
+	slog.Default().DebugContext(ctx, msg, args...)
+}
+
+// Info 普通日志
+func Info(ctx context.Context, msg string, args ...any) {
+	slog.Default().InfoContext(ctx, msg, args...)
+}
+
+// Warn 警告日志，用于不影响主流程的失败（如缓存、事件发布）
+func Warn(ctx context.Context, msg string, args ...any) {
+	slog.Default().WarnContext(ctx, msg, args...)
+}
+
+// Error 错误日志
+func Error(ctx context.Context, msg string, args ...any) {
+	slog.Default().ErrorContext(ctx, msg, args...)
+}
+
+// Fatal 输出错误日志后退出进程，只在启动阶段使用
+func Fatal(msg string, args ...any) {
+	slog.Default().Error(msg, args...)
+	os.Exit(1)
+}
+
+// Err 错误字段
+func Err(err error) slog.Attr {
+	return slog.Any("error", err)
+}
+
+// Std 以指定级别输出到 slog 的标准库 logger，供只接受 *log.Logger 的组件使用
+func Std(level slog.Level) *log.Logger {
+	return slog.NewLogLogger(slog.Default().Handler(), level)
+}
+
+// contextHandler 从上下文中提取请求 ID 与 trace ID
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if ctx != nil {
+		if id := requestid.FromContext(ctx); id != "" {
+			r.AddAttrs(slog.String("request_id", id))
+		}
+		if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+			r.AddAttrs(
+				slog.String("trace_id", sc.TraceID().String()),
+				slog.String("span_id", sc.SpanID().String()),
+			)
+		}
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,35 @@
+package logger
+
+import (
+	"log/slog"
+	"strings"
+)
+
+// redacted 敏感字段的替换值
+const redacted = "[REDACTED]"
+
+// sensitiveKeys 字段名包含这些片段时整体替换
+var sensitiveKeys = []string{"password", "token", "secret", "authorization", "stream_key"}
+
+// redact 令牌、密码等字段整体替换，邮箱只保留首字母与域名
+func redact(_ []string, a slog.Attr) slog.Attr {
+	key := strings.ToLower(a.Key)
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return slog.String(a.Key, redacted)
+		}
+	}
+	if strings.Contains(key, "email") && a.Value.Kind() == slog.KindString {
+		return slog.String(a.Key, MaskEmail(a.Value.String()))
+	}
+	return a
+}
+
+// MaskEmail 邮箱脱敏：alice@example.com -> a***@example.com
+func MaskEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 {
+		return redacted
+	}
+	return email[:1] + "***" + email[at:]
+}
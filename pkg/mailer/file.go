@@ -0,0 +1,42 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"time"
+
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/pkg/requestid"
+)
+
+// FileMailer 把每封邮件写成 dir 下的一个 .eml 文件，可以直接用邮件客户端打开，测试也可以读取其中的链接
+type FileMailer struct {
+	from *mail.Address
+	dir  string
+}
+
+func NewFileMailer(from *mail.Address, dir string) (*FileMailer, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create mail dir: %w", err)
+	}
+	return &FileMailer{from: from, dir: dir}, nil
+}
+
+func (m *FileMailer) Send(ctx context.Context, msg *Message) error {
+	body, err := encode(m.from, msg)
+	if err != nil {
+		return err
+	}
+	// 文件名按时间排序
+	name := time.Now().UTC().Format("20060102T150405.000000000") + "-" + requestid.New()[:8] + ".eml"
+	path := filepath.Join(m.dir, name)
+	if err := os.WriteFile(path, body, 0o600); err != nil {
+		return fmt.Errorf("write mail: %w", err)
+	}
+	logger.Info(ctx, "Mail written to file", slog.String("to", logger.MaskEmail(msg.To)), slog.String("path", path))
+	return nil
+}
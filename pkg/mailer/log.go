@@ -0,0 +1,26 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/mail"
+
+	"live-stream-platform/pkg/logger"
+)
+
+// LogMailer 只把邮件内容记录到日志，邮件中的链接会出现在日志里，只能用于本地开发
+type LogMailer struct{}
+
+func NewLogMailer() *LogMailer {
+	return &LogMailer{}
+}
+
+func (m *LogMailer) Send(ctx context.Context, msg *Message) error {
+	if _, err := mail.ParseAddress(msg.To); err != nil {
+		return fmt.Errorf("invalid recipient: %w", err)
+	}
+	logger.Info(ctx, "Mail not sent, logged only",
+		slog.String("to", logger.MaskEmail(msg.To)), slog.String("subject", msg.Subject), slog.String("text", msg.Text))
+	return nil
+}
@@ -0,0 +1,85 @@
+// Package mailer 发送邮件。生产环境使用 SMTP，本地开发与测试可以把邮件写入文件或只记录日志
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"time"
+
+	"live-stream-platform/pkg/config"
+	"live-stream-platform/pkg/requestid"
+)
+
+// Message 纯文本邮件
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+}
+
+// Mailer 邮件发送
+type Mailer interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// New 按 cfg.Driver 创建 Mailer
+func New(cfg *config.MailConfig) (Mailer, error) {
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mail.from: %w", err)
+	}
+	switch cfg.Driver {
+	case "smtp":
+		return NewSMTPMailer(from, cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword), nil
+	case "file":
+		return NewFileMailer(from, cfg.Dir)
+	case "log":
+		return NewLogMailer(), nil
+	default:
+		return nil, fmt.Errorf("unsupported mail driver %q", cfg.Driver)
+	}
+}
+
+// encode 生成 RFC 5322 格式的邮件，正文以 quoted-printable 编码
+func encode(from *mail.Address, msg *Message) ([]byte, error) {
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipient: %w", err)
+	}
+	if strings.ContainsAny(msg.Subject, "\r\n") {
+		return nil, errors.New("invalid subject")
+	}
+	domain := "localhost"
+	if _, host, ok := strings.Cut(from.Address, "@"); ok {
+		domain = host
+	}
+
+	var buf bytes.Buffer
+	header := func(key, value string) {
+		buf.WriteString(key + ": " + value + "\r\n")
+	}
+	header("From", from.String())
+	header("To", to.String())
+	header("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("Message-ID", "<"+requestid.New()+"@"+domain+">")
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=utf-8")
+	header("Content-Transfer-Encoding", "quoted-printable")
+	buf.WriteString("\r\n")
+
+	w := quotedprintable.NewWriter(&buf)
+	if _, err := w.Write([]byte(strings.ReplaceAll(msg.Text, "\n", "\r\n"))); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// UserRegistrations 注册成功的用户数
+	UserRegistrations = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "user",
+		Name:      "registrations_total",
+		Help:      "Number of successful user registrations.",
+	})
+	// UserLogins 登录次数，result 为 success / failure
+	UserLogins = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "user",
+		Name:      "logins_total",
+		Help:      "Number of login attempts, by result.",
+	}, []string{"result"})
+	// MailsSent 发送的邮件数，purpose 为 verify_email / reset_password，result 为 success / failure
+	MailsSent = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "user",
+		Name:      "mails_sent_total",
+		Help:      "Number of emails sent, by purpose and result.",
+	}, []string{"purpose", "result"})
+	// LiveRooms 当前直播中的房间数，由直播间服务周期性刷新
+	LiveRooms = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "room",
+		Name:      "live_rooms",
+		Help:      "Number of rooms currently live.",
+	})
+	// GiftsSent 送出的礼物数量，按礼物区分
+	GiftsSent = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "gift",
+		Name:      "sent_total",
+		Help:      "Number of gifts sent, by gift.",
+	}, []string{"gift"})
+	// GiftCoins 送礼消耗的金币数
+	GiftCoins = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "gift",
+		Name:      "coins_total",
+		Help:      "Coins spent on gifts.",
+	})
+)
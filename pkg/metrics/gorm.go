@@ -0,0 +1,122 @@
+package metrics
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gorm.io/gorm"
+)
+
+// gormStartKey 查询开始时间在 gorm 实例上的键
+const gormStartKey = "metrics:start_time"
+
+var dbQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: namespace,
+	Subsystem: "db",
+	Name:      "query_duration_seconds",
+	Help:      "Duration of database statements, by operation and table.",
+	Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+}, []string{"operation", "table", "result"})
+
+var (
+	dbReplicaHealthy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "replica_healthy",
+		Help:      "Whether a read replica is receiving reads (1) or excluded (0).",
+	}, []string{"replica"})
+	dbReplicaLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "replica_lag_seconds",
+		Help:      "Replication lag reported by the read replica.",
+	}, []string{"replica"})
+	dbReadsRouted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "reads_total",
+		Help:      "Read statements by target: replica, or primary when forced or no replica is healthy.",
+	}, []string{"target"})
+)
+
+// ObserveReplica 记录从库的健康状态与复制延迟
+func ObserveReplica(replica string, healthy bool, lag time.Duration) {
+	value := 0.0
+	if healthy {
+		value = 1
+	}
+	dbReplicaHealthy.WithLabelValues(replica).Set(value)
+	dbReplicaLag.WithLabelValues(replica).Set(lag.Seconds())
+}
+
+// ObserveRead 记录读语句实际发往 primary 还是 replica
+func ObserveRead(target string) {
+	dbReadsRouted.WithLabelValues(target).Inc()
+}
+
+// InstrumentSQL 采集独立连接池（如从库）的状态
+func InstrumentSQL(db *sql.DB, name string) {
+	register(collectors.NewDBStatsCollector(db, name))
+}
+
+// InstrumentGORM 为 db 注册语句耗时回调，并采集连接池状态（sql.DB.Stats）
+func InstrumentGORM(db *gorm.DB, dbName string) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	register(collectors.NewDBStatsCollector(sqlDB, dbName))
+
+	cb := db.Callback()
+	processors := []struct {
+		operation string
+		before    func(name string, fn func(*gorm.DB)) error
+		after     func(name string, fn func(*gorm.DB)) error
+	}{
+		{"create", cb.Create().Before("gorm:create").Register, cb.Create().After("gorm:create").Register},
+		{"query", cb.Query().Before("gorm:query").Register, cb.Query().After("gorm:query").Register},
+		{"update", cb.Update().Before("gorm:update").Register, cb.Update().After("gorm:update").Register},
+		{"delete", cb.Delete().Before("gorm:delete").Register, cb.Delete().After("gorm:delete").Register},
+		{"row", cb.Row().Before("gorm:row").Register, cb.Row().After("gorm:row").Register},
+		{"raw", cb.Raw().Before("gorm:raw").Register, cb.Raw().After("gorm:raw").Register},
+	}
+	for _, p := range processors {
+		if err := p.before("metrics:before_"+p.operation, beforeStatement); err != nil {
+			return err
+		}
+		if err := p.after("metrics:after_"+p.operation, afterStatement(p.operation)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func beforeStatement(db *gorm.DB) {
+	db.InstanceSet(gormStartKey, time.Now())
+}
+
+func afterStatement(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		value, ok := db.InstanceGet(gormStartKey)
+		if !ok {
+			return
+		}
+		start, ok := value.(time.Time)
+		if !ok {
+			return
+		}
+		table := db.Statement.Table
+		if table == "" {
+			table = "unknown"
+		}
+		err := db.Error
+		if err == gorm.ErrRecordNotFound {
+			// 未找到记录属于正常结果
+			err = nil
+		}
+		dbQueryDuration.WithLabelValues(operation, table, result(err)).Observe(time.Since(start).Seconds())
+	}
+}
@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var grpcServerHandling = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: namespace,
+	Subsystem: "grpc_server",
+	Name:      "handling_seconds",
+	Help:      "Duration of gRPC requests handled by the server, by method and status code.",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"service", "method", "code"})
+
+// UnaryServerInterceptor 记录一元调用的耗时与状态码
+func UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	observeGRPC(info.FullMethod, err, start)
+	return resp, err
+}
+
+// StreamServerInterceptor 记录流式调用的耗时与状态码
+func StreamServerInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	observeGRPC(info.FullMethod, err, start)
+	return err
+}
+
+func observeGRPC(fullMethod string, err error, start time.Time) {
+	service, method := splitMethod(fullMethod)
+	grpcServerHandling.WithLabelValues(service, method, status.Code(err).String()).
+		Observe(time.Since(start).Seconds())
+}
+
+// splitMethod 将 /user.UserService/Login 拆分为服务名与方法名
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
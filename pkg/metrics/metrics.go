@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"live-stream-platform/pkg/logger"
+)
+
+// namespace 所有指标的前缀
+const namespace = "live"
+
+// Handler /metrics 端点
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Serve 在独立端口上暴露 /metrics，返回的服务器由调用方在关停时 Shutdown。
+// 端口在返回前监听，被占用（如多个服务配置了同一端口）时直接返回错误
+func Serve(port string) (*http.Server, error) {
+	list, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		return nil, fmt.Errorf("metrics: listen on %s: %w", port, err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", Handler())
+	server := &http.Server{
+		Addr:              ":" + port,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	go func() {
+		logger.Info(context.Background(), "Metrics listening", slog.String("port", port))
+		if err := server.Serve(list); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error(context.Background(), "Metrics server stopped", logger.Err(err))
+		}
+	}()
+	return server, nil
+}
+
+// register 注册采集器，重复注册（如重复初始化）时忽略
+func register(c prometheus.Collector) {
+	if err := prometheus.Register(c); err != nil {
+		var already prometheus.AlreadyRegisteredError
+		if !errors.As(err, &already) {
+			logger.Warn(context.Background(), "Failed to register metrics collector", logger.Err(err))
+		}
+	}
+}
+
+func result(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "ok"
+}
@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	rabbitmqPublished = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "rabbitmq",
+		Name:      "published_total",
+		Help:      "Number of messages published to RabbitMQ, by routing key and result.",
+	}, []string{"routing_key", "result"})
+	rabbitmqReconnects = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "rabbitmq",
+		Name:      "reconnects_total",
+		Help:      "Number of RabbitMQ reconnect attempts, by result.",
+	}, []string{"result"})
+	rabbitmqConsumed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "rabbitmq",
+		Name:      "consumed_total",
+		Help:      "Number of messages handled by consumers, by queue and outcome (ack, retry, dead_letter, requeue).",
+	}, []string{"queue", "outcome"})
+	rabbitmqHandleDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "rabbitmq",
+		Name:      "handle_duration_seconds",
+		Help:      "Duration of consumer handlers, by queue.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"queue"})
+	outboxRelayed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "outbox",
+		Name:      "relayed_total",
+		Help:      "Number of outbox messages relayed to RabbitMQ, by result (sent, unroutable, failed).",
+	}, []string{"result"})
+	outboxRelayDelay = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "outbox",
+		Name:      "relay_delay_seconds",
+		Help:      "Time from writing an outbox message to its publish attempt.",
+		Buckets:   []float64{.01, .05, .1, .5, 1, 2.5, 5, 10, 30, 60, 300},
+	})
+)
+
+// ObservePublish 记录一次消息发布
+func ObservePublish(routingKey string, err error) {
+	rabbitmqPublished.WithLabelValues(routingKey, result(err)).Inc()
+}
+
+// ObserveReconnect 记录一次重连尝试
+func ObserveReconnect(err error) {
+	rabbitmqReconnects.WithLabelValues(result(err)).Inc()
+}
+
+// ObserveConsume 记录一次消息处理的结果与耗时
+func ObserveConsume(queue, outcome string, elapsed time.Duration) {
+	rabbitmqConsumed.WithLabelValues(queue, outcome).Inc()
+	rabbitmqHandleDuration.WithLabelValues(queue).Observe(elapsed.Seconds())
+}
+
+// ObserveOutboxRelay 记录一次发件箱消息的发布结果，以及从写入到发布的延迟
+func ObserveOutboxRelay(result string, delay time.Duration) {
+	outboxRelayed.WithLabelValues(result).Inc()
+	outboxRelayDelay.Observe(delay.Seconds())
+}
@@ -0,0 +1,57 @@
+package migrate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+)
+
+var nameRe = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// Create 在 dir 下生成下一个版本的空 up/down 文件，返回两个文件的路径
+func Create(dir, name string) (up, down string, err error) {
+	if !nameRe.MatchString(name) {
+		return "", "", fmt.Errorf("migrate: name %q must match [a-z0-9_]+", name)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", "", err
+	}
+	var latest int64
+	for _, entry := range entries {
+		m := fileRe.FindStringSubmatch(entry.Name())
+		if m == nil {
+			continue
+		}
+		if version, err := strconv.ParseInt(m[1], 10, 64); err == nil && version > latest {
+			latest = version
+		}
+	}
+
+	base := fmt.Sprintf("%04d_%s", latest+1, name)
+	up = filepath.Join(dir, base+".up.sql")
+	down = filepath.Join(dir, base+".down.sql")
+	if err := writeNew(up, "-- "+base+" up\n"); err != nil {
+		return "", "", err
+	}
+	if err := writeNew(down, "-- "+base+" down\n"); err != nil {
+		os.Remove(up)
+		return "", "", err
+	}
+	return up, down, nil
+}
+
+// writeNew 创建文件，已存在时报错而不是覆盖
+func writeNew(path, content string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteString(content); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
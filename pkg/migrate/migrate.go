@@ -0,0 +1,357 @@
+// Package migrate 按版本执行各服务的 SQL 迁移脚本。
+// 执行记录保存在 schema_migrations 表，多个服务共用一个库时以 service 列区分；
+// 执行期间持有 MySQL 咨询锁，多个实例同时启动时只有一个会真正执行迁移。
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"time"
+)
+
+// DefaultLockTimeout 等待其他实例释放迁移锁的最长时间
+const DefaultLockTimeout = time.Minute
+
+const createTableSQL = `CREATE TABLE IF NOT EXISTS schema_migrations (
+	service varchar(50) NOT NULL,
+	version bigint NOT NULL,
+	name varchar(255) NOT NULL,
+	checksum char(64) NOT NULL,
+	dirty tinyint(1) NOT NULL DEFAULT 0,
+	applied_at datetime(3) NOT NULL,
+	PRIMARY KEY (service, version)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`
+
+var (
+	// ErrLockTimeout 等待迁移锁超时
+	ErrLockTimeout = errors.New("migrate: timed out waiting for migration lock")
+	// ErrDirty 上次迁移中途失败，需人工修复表结构后用 Force 标记
+	ErrDirty = errors.New("migrate: database is dirty")
+	// ErrUnknownVersion 指定的版本没有对应的迁移文件
+	ErrUnknownVersion = errors.New("migrate: unknown version")
+	// ErrChecksumMismatch 已执行的 up 脚本被修改
+	ErrChecksumMismatch = errors.New("migrate: checksum mismatch")
+)
+
+// Status 单个版本的执行状态
+type Status struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+	// Dirty 执行中途失败
+	Dirty bool
+	// Modified 已执行的 up 脚本与当前文件不一致
+	Modified bool
+	// Missing 库中有执行记录但找不到对应文件（通常是更新版本的服务执行过）
+	Missing bool
+}
+
+// applied schema_migrations 中的一行
+type applied struct {
+	version   int64
+	name      string
+	checksum  string
+	dirty     bool
+	appliedAt time.Time
+}
+
+// Migrator 执行某个服务的迁移
+type Migrator struct {
+	db          *sql.DB
+	service     string
+	migrations  []*Migration
+	lockTimeout time.Duration
+}
+
+// New 从 fsys 读取迁移文件
+func New(db *sql.DB, service string, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{
+		db:          db,
+		service:     service,
+		migrations:  migrations,
+		lockTimeout: DefaultLockTimeout,
+	}, nil
+}
+
+// Up 按版本顺序执行所有未执行的迁移，返回本次执行的迁移
+func (m *Migrator) Up(ctx context.Context) ([]*Migration, error) {
+	var done []*Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		history, err := m.history(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := m.verify(history); err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			if _, ok := history[mig.Version]; ok {
+				continue
+			}
+			if err := m.apply(ctx, conn, mig); err != nil {
+				return err
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Down 按版本倒序回滚最近执行的 steps 个迁移，返回本次回滚的迁移
+func (m *Migrator) Down(ctx context.Context, steps int) ([]*Migration, error) {
+	if steps <= 0 {
+		return nil, fmt.Errorf("migrate: steps must be positive, got %d", steps)
+	}
+	var done []*Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		history, err := m.history(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := m.verify(history); err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && len(done) < steps; i-- {
+			mig := m.migrations[i]
+			if _, ok := history[mig.Version]; !ok {
+				continue
+			}
+			if err := m.revert(ctx, conn, mig); err != nil {
+				return err
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Baseline 把 version 及之前尚未记录的迁移标记为已执行但不执行脚本，返回本次标记的迁移。
+// 用于接入迁移工具之前已由 AutoMigrate 或手工建好表的数据库
+func (m *Migrator) Baseline(ctx context.Context, version int64) ([]*Migration, error) {
+	if m.find(version) == nil {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+	}
+	var done []*Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		history, err := m.history(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := m.verify(history); err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			if mig.Version > version {
+				break
+			}
+			if _, ok := history[mig.Version]; ok {
+				continue
+			}
+			if err := m.record(ctx, conn, mig); err != nil {
+				return err
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Force 把 version 标记为已执行且不脏，不执行脚本。
+// 迁移中途失败、人工把表结构修复到与该版本 up 脚本一致后使用
+func (m *Migrator) Force(ctx context.Context, version int64) error {
+	mig := m.find(version)
+	if mig == nil {
+		return fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+	}
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		return m.record(ctx, conn, mig)
+	})
+}
+
+// Status 列出所有版本的执行状态，按版本号排序
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	if _, err := m.db.ExecContext(ctx, createTableSQL); err != nil {
+		return nil, fmt.Errorf("migrate: create schema_migrations: %w", err)
+	}
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	history, err := m.history(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Status
+	known := make(map[int64]bool, len(m.migrations))
+	for _, mig := range m.migrations {
+		known[mig.Version] = true
+		s := Status{Version: mig.Version, Name: mig.Name}
+		if row, ok := history[mig.Version]; ok {
+			s.Applied = true
+			s.AppliedAt = row.appliedAt
+			s.Dirty = row.dirty
+			s.Modified = row.checksum != mig.Checksum
+		}
+		result = append(result, s)
+	}
+	for version, row := range history {
+		if known[version] {
+			continue
+		}
+		result = append(result, Status{
+			Version:   version,
+			Name:      row.name,
+			Applied:   true,
+			AppliedAt: row.appliedAt,
+			Dirty:     row.dirty,
+			Missing:   true,
+		})
+	}
+	sortStatus(result)
+	return result, nil
+}
+
+// verify 拒绝在脏状态或脚本被修改后继续迁移。
+// 库中存在当前文件没有的版本时不报错，滚动发布期间旧版本实例仍可正常启动。
+func (m *Migrator) verify(history map[int64]applied) error {
+	for _, row := range history {
+		if row.dirty {
+			return fmt.Errorf("%w: version %d (%s) failed halfway, fix the schema by hand and run migrate force %d",
+				ErrDirty, row.version, row.name, row.version)
+		}
+	}
+	for _, mig := range m.migrations {
+		if row, ok := history[mig.Version]; ok && row.checksum != mig.Checksum {
+			return fmt.Errorf("%w: version %d (%s) was modified after it was applied, add a new migration instead",
+				ErrChecksumMismatch, mig.Version, mig.Name)
+		}
+	}
+	return nil
+}
+
+// apply 执行 up 脚本。MySQL 的 DDL 会隐式提交，无法整体回滚，
+// 因此先写入 dirty 记录，全部语句成功后再清除。
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, mig *Migration) error {
+	if _, err := conn.ExecContext(ctx,
+		"INSERT INTO schema_migrations (service, version, name, checksum, dirty, applied_at) VALUES (?, ?, ?, ?, 1, ?)",
+		m.service, mig.Version, mig.Name, mig.Checksum, time.Now()); err != nil {
+		return fmt.Errorf("migrate: record version %d: %w", mig.Version, err)
+	}
+	if err := execScript(ctx, conn, mig.Up); err != nil {
+		return fmt.Errorf("migrate: apply %d_%s: %w", mig.Version, mig.Name, err)
+	}
+	if _, err := conn.ExecContext(ctx,
+		"UPDATE schema_migrations SET dirty = 0 WHERE service = ? AND version = ?",
+		m.service, mig.Version); err != nil {
+		return fmt.Errorf("migrate: record version %d: %w", mig.Version, err)
+	}
+	return nil
+}
+
+// revert 执行 down 脚本，成功后删除执行记录
+func (m *Migrator) revert(ctx context.Context, conn *sql.Conn, mig *Migration) error {
+	if _, err := conn.ExecContext(ctx,
+		"UPDATE schema_migrations SET dirty = 1 WHERE service = ? AND version = ?",
+		m.service, mig.Version); err != nil {
+		return fmt.Errorf("migrate: record version %d: %w", mig.Version, err)
+	}
+	if err := execScript(ctx, conn, mig.Down); err != nil {
+		return fmt.Errorf("migrate: revert %d_%s: %w", mig.Version, mig.Name, err)
+	}
+	if _, err := conn.ExecContext(ctx,
+		"DELETE FROM schema_migrations WHERE service = ? AND version = ?",
+		m.service, mig.Version); err != nil {
+		return fmt.Errorf("migrate: record version %d: %w", mig.Version, err)
+	}
+	return nil
+}
+
+// record 写入或覆盖执行记录，清除 dirty 标记
+func (m *Migrator) record(ctx context.Context, conn *sql.Conn, mig *Migration) error {
+	if _, err := conn.ExecContext(ctx,
+		`INSERT INTO schema_migrations (service, version, name, checksum, dirty, applied_at) VALUES (?, ?, ?, ?, 0, ?)
+		ON DUPLICATE KEY UPDATE name = VALUES(name), checksum = VALUES(checksum), dirty = 0`,
+		m.service, mig.Version, mig.Name, mig.Checksum, time.Now()); err != nil {
+		return fmt.Errorf("migrate: record version %d: %w", mig.Version, err)
+	}
+	return nil
+}
+
+func (m *Migrator) find(version int64) *Migration {
+	for _, mig := range m.migrations {
+		if mig.Version == version {
+			return mig
+		}
+	}
+	return nil
+}
+
+func execScript(ctx context.Context, conn *sql.Conn, script string) error {
+	for _, stmt := range splitStatements(script) {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) history(ctx context.Context, conn *sql.Conn) (map[int64]applied, error) {
+	rows, err := conn.QueryContext(ctx,
+		"SELECT version, name, checksum, dirty, applied_at FROM schema_migrations WHERE service = ?", m.service)
+	if err != nil {
+		return nil, fmt.Errorf("migrate: read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+	history := make(map[int64]applied)
+	for rows.Next() {
+		var row applied
+		if err := rows.Scan(&row.version, &row.name, &row.checksum, &row.dirty, &row.appliedAt); err != nil {
+			return nil, err
+		}
+		history[row.version] = row
+	}
+	return history, rows.Err()
+}
+
+// withLock 在同一连接上持有咨询锁执行 fn，GET_LOCK 的锁属于会话，连接关闭时自动释放
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	lockName := "schema_migrations:" + m.service
+	var got sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", lockName, int(m.lockTimeout.Seconds())).Scan(&got); err != nil {
+		return fmt.Errorf("migrate: acquire lock: %w", err)
+	}
+	if !got.Valid || got.Int64 != 1 {
+		return ErrLockTimeout
+	}
+	defer conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", lockName)
+
+	// 拿到锁后再建表，避免并发建表
+	if _, err := conn.ExecContext(ctx, createTableSQL); err != nil {
+		return fmt.Errorf("migrate: create schema_migrations: %w", err)
+	}
+	return fn(conn)
+}
+
+func sortStatus(s []Status) {
+	sort.Slice(s, func(i, j int) bool { return s[i].Version < s[j].Version })
+}
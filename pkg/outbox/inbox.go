@@ -0,0 +1,66 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	commonPb "live-stream-platform/gen/proto/common"
+	"live-stream-platform/pkg/events"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/pkg/rabbitmq"
+)
+
+// processedEvent processed_events 表的一行，记录消费者已处理过的事件
+type processedEvent struct {
+	Consumer    string `gorm:"primaryKey"`
+	EventID     string `gorm:"primaryKey"`
+	ProcessedAt time.Time
+}
+
+func (processedEvent) TableName() string {
+	return "processed_events"
+}
+
+// Once 在同一个事务中登记 (consumer, eventID) 并执行 fn，事件已处理过时跳过 fn 并返回 false。
+// 同一事件并发投递时，后到的事务会等待先到的提交后再判断
+func Once(ctx context.Context, db *gorm.DB, consumer, eventID string, fn func(tx *gorm.DB) error) (bool, error) {
+	ran := false
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.Insert{Modifier: "IGNORE"}).Create(&processedEvent{
+			Consumer:    consumer,
+			EventID:     eventID,
+			ProcessedAt: time.Now(),
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		ran = true
+		return fn(tx)
+	})
+	if err != nil {
+		return false, err
+	}
+	return ran, nil
+}
+
+// HandleEvent 在 events.Handle 的基础上按事件 ID 去重：fn 的数据库写入必须使用传入的 tx，
+// 与去重记录一起提交；重复投递的事件直接确认
+func HandleEvent[T proto.Message](db *gorm.DB, consumer string, fn func(ctx context.Context, tx *gorm.DB, env *commonPb.EventEnvelope, payload T) error) rabbitmq.Handler {
+	return events.Handle(func(ctx context.Context, env *commonPb.EventEnvelope, payload T) error {
+		ran, err := Once(ctx, db, consumer, env.EventId, func(tx *gorm.DB) error {
+			return fn(ctx, tx, env, payload)
+		})
+		if err == nil && !ran {
+			logger.Debug(ctx, "Skipped duplicate event",
+				slog.String("consumer", consumer), slog.String("event_id", env.EventId), slog.String("type", env.Type))
+		}
+		return err
+	})
+}
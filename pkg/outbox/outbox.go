@@ -0,0 +1,63 @@
+// Package outbox 事务性发件箱：事件与业务数据在同一个 MySQL 事务中写入 outbox_messages，
+// 提交后由 Relay 发布到 RabbitMQ，保证数据与事件不会只成功一边。
+// Relay 至少投递一次，消费方用 HandleEvent 按事件 ID 去重。
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+	"live-stream-platform/pkg/events"
+)
+
+// Message outbox_messages 表的一行，保存发布所需的全部消息属性
+type Message struct {
+	ID          int64 `gorm:"primaryKey"`
+	EventID     string
+	RoutingKey  string
+	ContentType string
+	Producer    string
+	Body        []byte
+	// TraceContext 写入时的 trace-context（JSON），Relay 发布时恢复，使消费端链路接在原请求之后
+	TraceContext  string
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time
+	SentAt        *time.Time
+	CreatedAt     time.Time
+}
+
+func (Message) TableName() string {
+	return "outbox_messages"
+}
+
+// Enqueue 在 tx 所在的事务中写入事件，事务提交后由 Relay 发布；tx 回滚时事件随之丢弃
+func Enqueue(ctx context.Context, tx *gorm.DB, payload proto.Message) error {
+	msg, err := events.NewMessage(ctx, payload)
+	if err != nil {
+		return err
+	}
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	traceContext, err := json.Marshal(carrier)
+	if err != nil {
+		return fmt.Errorf("outbox: encode trace context: %w", err)
+	}
+	now := time.Now()
+	return tx.WithContext(ctx).Create(&Message{
+		EventID:       msg.MessageId,
+		RoutingKey:    msg.Type,
+		ContentType:   msg.ContentType,
+		Producer:      msg.AppId,
+		Body:          msg.Body,
+		TraceContext:  string(traceContext),
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}).Error
+}
@@ -0,0 +1,229 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/pkg/metrics"
+	"live-stream-platform/pkg/rabbitmq"
+)
+
+const (
+	// cleanupInterval 清理已发送消息的间隔
+	cleanupInterval = time.Hour
+	// cleanupBatch 每条 DELETE 最多删除的行数，避免长时间持有锁
+	cleanupBatch = 1000
+	// maxErrorLength last_error 列的长度
+	maxErrorLength = 512
+)
+
+// RelayOptions Relay 配置，零值字段使用默认值
+type RelayOptions struct {
+	// BatchSize 每个事务最多发布的消息数，默认 100
+	BatchSize int
+	// PollInterval 没有积压时的轮询间隔，默认 1s
+	PollInterval time.Duration
+	// RetryBackoff 发布失败后首次重试的延迟，之后逐次翻倍，默认 1s
+	RetryBackoff time.Duration
+	// MaxRetryBackoff 重试延迟上限，默认 5m
+	MaxRetryBackoff time.Duration
+	// Retention 已发送消息的保留时间，默认 7 天，负数表示不清理
+	Retention time.Duration
+	// Subscribed 已知有消费者的绑定（支持 topic 通配符），匹配的消息被退回时说明队列尚未声明，
+	// 按发布失败重试；其他被退回的消息视为已发送
+	Subscribed []string
+}
+
+func (o *RelayOptions) setDefaults() {
+	if o.BatchSize <= 0 {
+		o.BatchSize = 100
+	}
+	if o.PollInterval <= 0 {
+		o.PollInterval = time.Second
+	}
+	if o.RetryBackoff <= 0 {
+		o.RetryBackoff = time.Second
+	}
+	if o.MaxRetryBackoff <= 0 {
+		o.MaxRetryBackoff = 5 * time.Minute
+	}
+	if o.Retention == 0 {
+		o.Retention = 7 * 24 * time.Hour
+	}
+}
+
+// Relay 把 outbox_messages 中未发送的消息发布到 RabbitMQ。
+// 以 SELECT ... FOR UPDATE SKIP LOCKED 领取消息，多个实例可以同时运行而不会重复领取；
+// 发布成功但标记前崩溃的消息会再次发布，因此投递语义是至少一次
+type Relay struct {
+	db   *gorm.DB
+	opts RelayOptions
+}
+
+func NewRelay(db *gorm.DB, opts RelayOptions) *Relay {
+	opts.setDefaults()
+	return &Relay{db: db, opts: opts}
+}
+
+// Run 持续发布直到 ctx 结束，正在处理的批次会完成后再返回
+func (r *Relay) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.opts.PollInterval)
+	defer ticker.Stop()
+	var lastCleanup time.Time
+	for {
+		// 批次内的发布与标记不随 ctx 取消中断，否则已发布的消息来不及标记
+		more, err := r.relayBatch(context.WithoutCancel(ctx))
+		if err != nil {
+			logger.Warn(ctx, "Failed to relay outbox messages", logger.Err(err))
+		}
+		if r.opts.Retention > 0 && time.Since(lastCleanup) >= cleanupInterval {
+			r.cleanup(ctx)
+			lastCleanup = time.Now()
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+		// 整批发布成功说明可能还有积压，不等待直接继续
+		if more {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// relayBatch 在一个事务中领取并发布一批消息。遇到发布失败时停止本批次：
+// 失败通常意味着 broker 不可用，后续消息也会失败
+func (r *Relay) relayBatch(ctx context.Context) (more bool, err error) {
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var msgs []*Message
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("sent_at IS NULL AND next_attempt_at <= ?", time.Now()).
+			Order("id ASC").
+			Limit(r.opts.BatchSize).
+			Find(&msgs).Error; err != nil {
+			return err
+		}
+
+		sent := make([]int64, 0, len(msgs))
+		var failed *Message
+		var publishErr error
+		for _, msg := range msgs {
+			if publishErr = r.publish(ctx, msg); publishErr != nil {
+				failed = msg
+				break
+			}
+			sent = append(sent, msg.ID)
+		}
+
+		now := time.Now()
+		if len(sent) > 0 {
+			if err := tx.Model(&Message{}).Where("id IN ?", sent).Updates(map[string]any{
+				"sent_at":  now,
+				"attempts": gorm.Expr("attempts + 1"),
+			}).Error; err != nil {
+				return err
+			}
+		}
+		if failed != nil {
+			attempts := failed.Attempts + 1
+			logger.Warn(ctx, "Failed to publish outbox message",
+				slog.String("event_id", failed.EventID),
+				slog.String("routing_key", failed.RoutingKey),
+				slog.Int("attempts", attempts),
+				logger.Err(publishErr))
+			if err := tx.Model(&Message{}).Where("id = ?", failed.ID).Updates(map[string]any{
+				"attempts":        attempts,
+				"last_error":      truncate(publishErr.Error(), maxErrorLength),
+				"next_attempt_at": now.Add(r.backoff(attempts)),
+			}).Error; err != nil {
+				return err
+			}
+		}
+		more = failed == nil && len(msgs) == r.opts.BatchSize
+		return nil
+	})
+	return more, err
+}
+
+// publish 以写入时的 trace-context 发布一条消息。
+// 没有队列绑定该路由键时 broker 会退回消息，除非该路由键在 Subscribed 中，否则视为已发送：重试也无人接收
+func (r *Relay) publish(ctx context.Context, msg *Message) error {
+	carrier := propagation.MapCarrier{}
+	if msg.TraceContext != "" {
+		_ = json.Unmarshal([]byte(msg.TraceContext), &carrier)
+	}
+	ctx = otel.GetTextMapPropagator().Extract(ctx, carrier)
+	err := rabbitmq.PublishMessage(ctx, msg.RoutingKey, amqp.Publishing{
+		ContentType: msg.ContentType,
+		Type:        msg.RoutingKey,
+		MessageId:   msg.EventID,
+		AppId:       msg.Producer,
+		Timestamp:   msg.CreatedAt,
+		Body:        msg.Body,
+	})
+	switch {
+	case err == nil:
+		metrics.ObserveOutboxRelay("sent", time.Since(msg.CreatedAt))
+	case errors.Is(err, rabbitmq.ErrUnroutable) && !r.subscribed(msg.RoutingKey):
+		logger.Warn(ctx, "Outbox message has no subscribers",
+			slog.String("event_id", msg.EventID), slog.String("routing_key", msg.RoutingKey))
+		metrics.ObserveOutboxRelay("unroutable", time.Since(msg.CreatedAt))
+		return nil
+	default:
+		metrics.ObserveOutboxRelay("failed", time.Since(msg.CreatedAt))
+	}
+	return err
+}
+
+func (r *Relay) subscribed(routingKey string) bool {
+	for _, pattern := range r.opts.Subscribed {
+		if rabbitmq.MatchTopic(pattern, routingKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff 第 attempts 次失败后的重试延迟
+func (r *Relay) backoff(attempts int) time.Duration {
+	d := r.opts.RetryBackoff
+	for i := 1; i < attempts && d < r.opts.MaxRetryBackoff; i++ {
+		d *= 2
+	}
+	return min(d, r.opts.MaxRetryBackoff)
+}
+
+// cleanup 分批删除超过保留时间的已发送消息
+func (r *Relay) cleanup(ctx context.Context) {
+	cutoff := time.Now().Add(-r.opts.Retention)
+	for ctx.Err() == nil {
+		result := r.db.WithContext(ctx).Exec("DELETE FROM outbox_messages WHERE sent_at < ? LIMIT ?", cutoff, cleanupBatch)
+		if result.Error != nil {
+			logger.Warn(ctx, "Failed to clean up outbox messages", logger.Err(result.Error))
+			return
+		}
+		if result.RowsAffected < cleanupBatch {
+			return
+		}
+	}
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
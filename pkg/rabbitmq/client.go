@@ -0,0 +1,489 @@
+package rabbitmq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+	"go.opentelemetry.io/otel/trace"
+	"live-stream-platform/pkg/config"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/pkg/metrics"
+	"live-stream-platform/pkg/tracing"
+)
+
+const (
+	// channelPoolSize 空闲发布 Channel 的上限，并发更高时临时打开，用完关闭
+	channelPoolSize = 16
+	// reconnectMinBackoff、reconnectMaxBackoff 重连退避的初始与最大间隔
+	reconnectMinBackoff = 500 * time.Millisecond
+	reconnectMaxBackoff = 30 * time.Second
+)
+
+var (
+	// ErrNotConnected 连接断开、正在重连
+	ErrNotConnected = errors.New("rabbitmq: not connected")
+	// ErrClosed 客户端已关闭
+	ErrClosed = errors.New("rabbitmq: client closed")
+	// ErrNacked broker 拒绝了消息
+	ErrNacked = errors.New("rabbitmq: message nacked by broker")
+	// ErrUnroutable 没有队列绑定该路由键，消息被退回
+	ErrUnroutable = errors.New("rabbitmq: message unroutable")
+	// ErrConfirmTimeout 等待确认超时，消息可能已投递，也可能丢失，重试时消费方需幂等
+	ErrConfirmTimeout = errors.New("rabbitmq: timed out waiting for publisher confirm")
+)
+
+// binding 队列与路由键的绑定，重连后重新声明
+type binding struct {
+	queue      string
+	routingKey string
+}
+
+// Client 带自动重连的 RabbitMQ 客户端。
+// 连接断开后按指数退避重连，重新声明交换机、持久队列与绑定，并恢复所有订阅。
+// amqp Channel 不能并发发布，发布使用 Channel 池，每个 Channel 同一时刻只被一个调用方持有。
+type Client struct {
+	cfg *config.RabbitMQConfig
+
+	mu       sync.RWMutex
+	conn     *amqp.Connection
+	queues   []string
+	bindings []binding
+	subs     map[*Subscription]struct{}
+
+	pool chan *pubChannel
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// pubChannel 处于 confirm 模式的发布 Channel
+type pubChannel struct {
+	ch *amqp.Channel
+	// returns mandatory 消息被退回时由 amqp 库写入；退回总是先于对应的 ack 到达
+	returns chan amqp.Return
+}
+
+// NewClient 连接 RabbitMQ 并声明交换机，之后在后台维护连接
+func NewClient(cfg *config.RabbitMQConfig) (*Client, error) {
+	c := &Client{
+		cfg:  cfg,
+		subs: make(map[*Subscription]struct{}),
+		pool: make(chan *pubChannel, channelPoolSize),
+		done: make(chan struct{}),
+	}
+	conn, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	c.conn = conn
+	c.wg.Add(1)
+	go c.watch(conn)
+	return c, nil
+}
+
+// dial 建立连接并声明交换机与已知的队列、绑定
+func (c *Client) dial() (*amqp.Connection, error) {
+	conn, err := amqp.Dial(c.cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect rabbitmq: %w", err)
+	}
+	ch, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to open channel: %w", err)
+	}
+	defer ch.Close()
+
+	if err := ch.ExchangeDeclare(
+		c.cfg.Exchange, // name
+		"topic",        // type
+		true,           // durable
+		false,          // auto-deleted
+		false,          // internal
+		false,          // no-wait
+		nil,            // arguments
+	); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to declare exchange: %w", err)
+	}
+
+	c.mu.RLock()
+	queues := append([]string(nil), c.queues...)
+	bindings := append([]binding(nil), c.bindings...)
+	c.mu.RUnlock()
+	for _, queue := range queues {
+		if _, err := declareQueue(ch, queue); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	for _, b := range bindings {
+		if err := ch.QueueBind(b.queue, b.routingKey, c.cfg.Exchange, false, nil); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to bind %s to %s: %w", b.queue, b.routingKey, err)
+		}
+	}
+	return conn, nil
+}
+
+// watch 等待连接关闭并重连，直到 Close
+func (c *Client) watch(conn *amqp.Connection) {
+	defer c.wg.Done()
+	for {
+		closed := conn.NotifyClose(make(chan *amqp.Error, 1))
+		select {
+		case <-c.done:
+			return
+		case amqpErr := <-closed:
+			// 主动 Close 时 closed 被直接关闭，amqpErr 为 nil
+			select {
+			case <-c.done:
+				return
+			default:
+			}
+			logger.Warn(context.Background(), "RabbitMQ connection lost, reconnecting", slog.Any("error", amqpErr))
+		}
+
+		c.mu.Lock()
+		c.conn = nil
+		c.mu.Unlock()
+		c.drainPool()
+
+		var ok bool
+		if conn, ok = c.reconnect(); !ok {
+			return
+		}
+	}
+}
+
+// reconnect 按指数退避重连，成功后恢复订阅；Close 时返回 false
+func (c *Client) reconnect() (*amqp.Connection, bool) {
+	backoff := reconnectMinBackoff
+	for attempt := 1; ; attempt++ {
+		// 加入抖动，避免大量实例同时重连
+		wait := backoff/2 + rand.N(backoff/2+1)
+		select {
+		case <-c.done:
+			return nil, false
+		case <-time.After(wait):
+		}
+
+		conn, err := c.dial()
+		metrics.ObserveReconnect(err)
+		if err != nil {
+			logger.Warn(context.Background(), "Failed to reconnect rabbitmq",
+				slog.Int("attempt", attempt), slog.Duration("backoff", backoff), logger.Err(err))
+			backoff = min(backoff*2, reconnectMaxBackoff)
+			continue
+		}
+
+		c.mu.Lock()
+		c.conn = conn
+		subs := make([]*Subscription, 0, len(c.subs))
+		for sub := range c.subs {
+			subs = append(subs, sub)
+		}
+		c.mu.Unlock()
+		for _, sub := range subs {
+			if err := sub.start(conn); err != nil {
+				logger.Error(context.Background(), "Failed to restore rabbitmq subscription", logger.Err(err))
+			}
+		}
+		logger.Info(context.Background(), "RabbitMQ reconnected", slog.Int("attempt", attempt))
+		return conn, true
+	}
+}
+
+func (c *Client) connection() (*amqp.Connection, error) {
+	select {
+	case <-c.done:
+		return nil, ErrClosed
+	default:
+	}
+	c.mu.RLock()
+	conn := c.conn
+	c.mu.RUnlock()
+	if conn == nil || conn.IsClosed() {
+		return nil, ErrNotConnected
+	}
+	return conn, nil
+}
+
+// getChannel 从池中取出一个可用的 Channel，池为空时新开
+func (c *Client) getChannel() (*pubChannel, error) {
+	for {
+		select {
+		case pc := <-c.pool:
+			if !pc.ch.IsClosed() {
+				return pc, nil
+			}
+		default:
+			conn, err := c.connection()
+			if err != nil {
+				return nil, err
+			}
+			ch, err := conn.Channel()
+			if err != nil {
+				return nil, fmt.Errorf("failed to open channel: %w", err)
+			}
+			if err := ch.Confirm(false); err != nil {
+				ch.Close()
+				return nil, fmt.Errorf("failed to enable publisher confirms: %w", err)
+			}
+			return &pubChannel{ch: ch, returns: ch.NotifyReturn(make(chan amqp.Return, 1))}, nil
+		}
+	}
+}
+
+// putChannel 归还 Channel，已关闭或池已满时直接关闭
+func (c *Client) putChannel(pc *pubChannel) {
+	if pc.ch.IsClosed() {
+		return
+	}
+	select {
+	case c.pool <- pc:
+	default:
+		pc.ch.Close()
+	}
+}
+
+func (c *Client) drainPool() {
+	for {
+		select {
+		case pc := <-c.pool:
+			pc.ch.Close()
+		default:
+			return
+		}
+	}
+}
+
+// withChannel 借用一个 Channel 执行声明等操作
+func (c *Client) withChannel(fn func(ch *amqp.Channel) error) error {
+	pc, err := c.getChannel()
+	if err != nil {
+		return err
+	}
+	defer c.putChannel(pc)
+	return fn(pc.ch)
+}
+
+// DeclareQueue 声明持久队列（名称加上配置的前缀），重连后自动重新声明
+func (c *Client) DeclareQueue(name string) (amqp.Queue, error) {
+	name = c.queueName(name)
+	var queue amqp.Queue
+	err := c.withChannel(func(ch *amqp.Channel) error {
+		var err error
+		queue, err = declareQueue(ch, name)
+		return err
+	})
+	if err != nil {
+		return queue, err
+	}
+	c.mu.Lock()
+	if !slices.Contains(c.queues, name) {
+		c.queues = append(c.queues, name)
+	}
+	c.mu.Unlock()
+	return queue, nil
+}
+
+// BindQueue 把持久队列绑定到路由键，重连后自动重新绑定
+func (c *Client) BindQueue(queueName, routingKey string) error {
+	b := binding{queue: c.queueName(queueName), routingKey: routingKey}
+	err := c.withChannel(func(ch *amqp.Channel) error {
+		return ch.QueueBind(b.queue, b.routingKey, c.cfg.Exchange, false, nil)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to bind %s to %s: %w", b.queue, b.routingKey, err)
+	}
+	c.mu.Lock()
+	if !slices.Contains(c.bindings, b) {
+		c.bindings = append(c.bindings, b)
+	}
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *Client) queueName(name string) string {
+	return fmt.Sprintf("%s_%s", c.cfg.Prefix, name)
+}
+
+func declareQueue(ch *amqp.Channel, name string) (amqp.Queue, error) {
+	queue, err := ch.QueueDeclare(
+		name,  // name
+		true,  // durable
+		false, // delete when unused
+		false, // exclusive
+		false, // no-wait
+		nil,   // arguments
+	)
+	if err != nil {
+		return queue, fmt.Errorf("failed to declare queue %s: %w", name, err)
+	}
+	return queue, nil
+}
+
+// Publish 发布消息，不等待 broker 确认，适用于允许丢失的消息（如弹幕）
+func (c *Client) Publish(ctx context.Context, routingKey string, body []byte) error {
+	ctx, span := c.startSpan(ctx, routingKey)
+	defer span.End()
+	err := c.publish(ctx, routingKey, body, false)
+	endSpan(span, routingKey, err)
+	return err
+}
+
+// PublishWithConfirm 以 mandatory 方式发布持久消息，并等待 broker 确认。
+// 没有队列接收时返回 ErrUnroutable，超过 ConfirmTimeout 未确认时返回 ErrConfirmTimeout
+func (c *Client) PublishWithConfirm(ctx context.Context, routingKey string, body []byte) error {
+	ctx, span := c.startSpan(ctx, routingKey)
+	defer span.End()
+	err := c.publish(ctx, routingKey, body, true)
+	endSpan(span, routingKey, err)
+	return err
+}
+
+// PublishMessage 与 PublishWithConfirm 相同，但由调用方指定 content-type、MessageId 等消息属性
+func (c *Client) PublishMessage(ctx context.Context, routingKey string, msg amqp.Publishing) error {
+	ctx, span := c.startSpan(ctx, routingKey)
+	defer span.End()
+	msg.Headers = tracing.InjectAMQP(ctx, msg.Headers)
+	msg.DeliveryMode = amqp.Persistent
+	err := c.publishConfirmed(ctx, c.cfg.Exchange, routingKey, msg)
+	endSpan(span, routingKey, err)
+	return err
+}
+
+func (c *Client) publish(ctx context.Context, routingKey string, body []byte, confirm bool) error {
+	msg := amqp.Publishing{
+		ContentType: "application/json",
+		Headers:     tracing.InjectAMQP(ctx, nil),
+		Body:        body,
+	}
+	if confirm {
+		msg.DeliveryMode = amqp.Persistent
+		return c.publishConfirmed(ctx, c.cfg.Exchange, routingKey, msg)
+	}
+
+	pc, err := c.getChannel()
+	if err != nil {
+		return err
+	}
+	defer c.putChannel(pc)
+	return pc.ch.PublishWithContext(ctx, c.cfg.Exchange, routingKey, false, false, msg)
+}
+
+// publishConfirmed 以 mandatory 方式发布并等待 broker 确认，msg 没有 MessageId 时自动生成
+func (c *Client) publishConfirmed(ctx context.Context, exchange, routingKey string, msg amqp.Publishing) error {
+	pc, err := c.getChannel()
+	if err != nil {
+		return err
+	}
+	defer c.putChannel(pc)
+
+	if msg.MessageId == "" {
+		msg.MessageId = newMessageID()
+	}
+	dc, err := pc.ch.PublishWithDeferredConfirmWithContext(ctx,
+		exchange,   // exchange
+		routingKey, // routing key
+		true,       // mandatory
+		false,      // immediate
+		msg,
+	)
+	if err != nil {
+		return err
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, c.cfg.ConfirmTimeout)
+	defer cancel()
+	acked, err := dc.WaitContext(waitCtx)
+	if err != nil {
+		// 确认未到达前归还的 Channel 会把迟到的确认当作下一条消息的，直接关闭
+		pc.ch.Close()
+		if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+			return ErrConfirmTimeout
+		}
+		return err
+	}
+	if !acked {
+		return ErrNacked
+	}
+	// 退回先于 ack 到达，此时若有退回一定已在 returns 中
+	for {
+		select {
+		case ret := <-pc.returns:
+			if ret.MessageId == msg.MessageId {
+				return fmt.Errorf("%w: %s (%s)", ErrUnroutable, routingKey, ret.ReplyText)
+			}
+		default:
+			return nil
+		}
+	}
+}
+
+func (c *Client) startSpan(ctx context.Context, routingKey string) (context.Context, trace.Span) {
+	return tracing.Tracer().Start(ctx, "rabbitmq.publish "+routingKey,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemRabbitmq,
+			semconv.MessagingDestinationName(c.cfg.Exchange),
+			semconv.MessagingRabbitmqDestinationRoutingKey(routingKey),
+		),
+	)
+}
+
+func endSpan(span trace.Span, routingKey string, err error) {
+	metrics.ObservePublish(routingKey, err)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+// Ping 检查连接是否可用，重连期间返回错误
+func (c *Client) Ping(_ context.Context) error {
+	_, err := c.connection()
+	return err
+}
+
+// Close 停止重连并关闭所有订阅与连接
+func (c *Client) Close() error {
+	select {
+	case <-c.done:
+		return nil
+	default:
+	}
+	close(c.done)
+
+	c.mu.Lock()
+	subs := make([]*Subscription, 0, len(c.subs))
+	for sub := range c.subs {
+		subs = append(subs, sub)
+	}
+	conn := c.conn
+	c.conn = nil
+	c.mu.Unlock()
+
+	for _, sub := range subs {
+		sub.Close()
+	}
+	c.drainPool()
+	var err error
+	if conn != nil && !conn.IsClosed() {
+		err = conn.Close()
+	}
+	c.wg.Wait()
+	return err
+}
+
+func newMessageID() string {
+	return fmt.Sprintf("%016x%016x", rand.Uint64(), rand.Uint64())
+}
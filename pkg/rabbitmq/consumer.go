@@ -0,0 +1,463 @@
+package rabbitmq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+	"go.opentelemetry.io/otel/trace"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/pkg/metrics"
+	"live-stream-platform/pkg/tracing"
+)
+
+// 消费者默认参数
+const (
+	defaultWorkers         = 1
+	defaultMaxRetries      = 5
+	defaultRetryBackoff    = time.Second
+	defaultMaxRetryBackoff = 10 * time.Minute
+	defaultDrainTimeout    = 30 * time.Second
+)
+
+// 重试相关的消息头
+const (
+	headerRetryCount         = "x-retry-count"
+	headerOriginalRoutingKey = "x-original-routing-key"
+	headerLastError          = "x-last-error"
+)
+
+// maxErrorHeaderLength 写入消息头的错误信息长度上限
+const maxErrorHeaderLength = 512
+
+// ConsumerOptions 消费者配置
+type ConsumerOptions struct {
+	// Queue 持久队列名，实际名称加上配置的前缀；同名消费者之间竞争消费
+	Queue string
+	// RoutingKeys 绑定到交换机的路由键，支持 topic 通配符，如 user.*
+	RoutingKeys []string
+	// Workers 并发处理的 goroutine 数，默认 1
+	Workers int
+	// Prefetch 未确认消息的上限（QoS），默认等于 Workers
+	Prefetch int
+	// MaxRetries 失败后的最大重试次数，超过后进入死信队列，默认 5，负数表示不重试
+	MaxRetries int
+	// RetryBackoff 首次重试的延迟，之后每次翻倍，默认 1s
+	RetryBackoff time.Duration
+	// MaxRetryBackoff 重试延迟的上限，默认 10m
+	MaxRetryBackoff time.Duration
+	// DrainTimeout 关闭时等待处理中消息完成的最长时间，超时未确认的消息由 broker 重新投递，默认 30s
+	DrainTimeout time.Duration
+}
+
+func (o *ConsumerOptions) setDefaults() {
+	if o.Workers <= 0 {
+		o.Workers = defaultWorkers
+	}
+	if o.Prefetch < o.Workers {
+		o.Prefetch = o.Workers
+	}
+	if o.MaxRetries < 0 {
+		o.MaxRetries = 0
+	} else if o.MaxRetries == 0 {
+		o.MaxRetries = defaultMaxRetries
+	}
+	if o.RetryBackoff <= 0 {
+		o.RetryBackoff = defaultRetryBackoff
+	}
+	if o.MaxRetryBackoff < o.RetryBackoff {
+		o.MaxRetryBackoff = max(defaultMaxRetryBackoff, o.RetryBackoff)
+	}
+	if o.DrainTimeout <= 0 {
+		o.DrainTimeout = defaultDrainTimeout
+	}
+}
+
+// Message 交给 Handler 的消息
+type Message struct {
+	// RoutingKey 发布时的路由键，重试后仍保持不变
+	RoutingKey  string
+	MessageID   string
+	ContentType string
+	Headers     amqp.Table
+	Body        []byte
+	// Attempt 第几次处理，从 1 开始
+	Attempt int
+}
+
+// Handler 处理一条消息：返回 nil 时确认；返回错误时延迟重试，超过次数或错误由 Permanent 包装时进入死信队列。
+// 消息可能被重复投递，Handler 需要幂等
+type Handler func(ctx context.Context, msg *Message) error
+
+// JSONHandler 把消息体解码为 T 后交给 fn，解码失败的消息直接进入死信队列
+func JSONHandler[T any](fn func(ctx context.Context, payload *T) error) Handler {
+	return func(ctx context.Context, msg *Message) error {
+		payload := new(T)
+		if err := json.Unmarshal(msg.Body, payload); err != nil {
+			return Permanent(fmt.Errorf("decode %s: %w", msg.RoutingKey, err))
+		}
+		return fn(ctx, payload)
+	}
+}
+
+// permanentError 重试也不会成功的错误
+type permanentError struct{ err error }
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent 标记错误不可重试，消息直接进入死信队列
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// Consumer 持久队列的消费者。
+// 处理失败的消息确认后重新发布到按延迟分级的重试队列（TTL 到期后经默认交换机死信回主队列），
+// 重试耗尽后发布到 <queue>.dlq，由人工排查后重放。
+type Consumer struct {
+	client  *Client
+	opts    ConsumerOptions
+	handler Handler
+
+	queue       string
+	retryQueues []retryQueue
+	deadLetter  string
+}
+
+// retryQueue 固定 TTL 的延迟队列，队列级 TTL 避免逐条 TTL 的队头阻塞
+type retryQueue struct {
+	name  string
+	delay time.Duration
+}
+
+// NewConsumer 创建消费者，队列与绑定在 Run 时声明
+func (c *Client) NewConsumer(opts ConsumerOptions, handler Handler) (*Consumer, error) {
+	if opts.Queue == "" {
+		return nil, errors.New("rabbitmq: consumer queue is required")
+	}
+	if len(opts.RoutingKeys) == 0 {
+		return nil, errors.New("rabbitmq: consumer needs at least one routing key")
+	}
+	opts.setDefaults()
+	queue := c.queueName(opts.Queue)
+	return &Consumer{
+		client:      c,
+		opts:        opts,
+		handler:     handler,
+		queue:       queue,
+		retryQueues: retryQueues(queue, opts),
+		deadLetter:  queue + ".dlq",
+	}, nil
+}
+
+// retryQueues 按 RetryBackoff 翻倍直到 MaxRetryBackoff 生成重试队列；
+// 队列名带上延迟，调整退避参数后会使用新队列，不会因参数不一致导致声明失败
+func retryQueues(queue string, opts ConsumerOptions) []retryQueue {
+	var queues []retryQueue
+	delay := opts.RetryBackoff
+	for i := 0; i < opts.MaxRetries; i++ {
+		queues = append(queues, retryQueue{name: fmt.Sprintf("%s.retry.%s", queue, delay), delay: delay})
+		if delay == opts.MaxRetryBackoff {
+			break
+		}
+		delay = min(delay*2, opts.MaxRetryBackoff)
+	}
+	return queues
+}
+
+// Declare 立即声明队列与绑定，Run 时还会再声明一次。
+// 同一进程既发布又消费时，在开始发布前调用，避免绑定建立前发布的消息被退回
+func (c *Consumer) Declare() error {
+	return c.client.withChannel(c.declare)
+}
+
+// Run 消费直到 ctx 结束，之后停止接收新消息并等待处理中的消息完成。
+// 连接断开时等待客户端重连后继续消费
+func (c *Consumer) Run(ctx context.Context) error {
+	backoff := reconnectMinBackoff
+	for {
+		started, err := c.consume(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if started {
+			backoff = reconnectMinBackoff
+		}
+		logger.Warn(ctx, "RabbitMQ consumer stopped, restarting",
+			slog.String("queue", c.queue), slog.Duration("backoff", backoff), logger.Err(err))
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, reconnectMaxBackoff)
+	}
+}
+
+// consume 在一个 Channel 上消费，Channel 关闭或 ctx 结束时返回
+func (c *Consumer) consume(ctx context.Context) (started bool, err error) {
+	conn, err := c.client.connection()
+	if err != nil {
+		return false, err
+	}
+	ch, err := conn.Channel()
+	if err != nil {
+		return false, fmt.Errorf("failed to open channel: %w", err)
+	}
+	defer ch.Close()
+
+	if err := c.declare(ch); err != nil {
+		return false, err
+	}
+	if err := ch.Qos(c.opts.Prefetch, 0, false); err != nil {
+		return false, fmt.Errorf("failed to set qos: %w", err)
+	}
+	tag := c.queue + "-" + newMessageID()[:8]
+	deliveries, err := ch.Consume(
+		c.queue, // queue
+		tag,     // consumer
+		false,   // auto-ack
+		false,   // exclusive
+		false,   // no-local
+		false,   // no-wait
+		nil,     // args
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to consume %s: %w", c.queue, err)
+	}
+	closed := ch.NotifyClose(make(chan *amqp.Error, 1))
+
+	// 处理中的消息不随 ctx 取消而中断，由 DrainTimeout 兜底
+	handleCtx := context.WithoutCancel(ctx)
+	var wg sync.WaitGroup
+	for i := 0; i < c.opts.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for delivery := range deliveries {
+				c.handle(handleCtx, delivery)
+			}
+		}()
+	}
+	logger.Info(ctx, "RabbitMQ consumer started", slog.String("queue", c.queue), slog.Int("workers", c.opts.Workers))
+
+	select {
+	case amqpErr := <-closed:
+		wg.Wait()
+		return true, fmt.Errorf("channel closed: %v", amqpErr)
+	case <-ctx.Done():
+	}
+
+	// 停止投递新消息，已预取的消息处理完后 deliveries 关闭
+	if err := ch.Cancel(tag, false); err != nil {
+		logger.Warn(ctx, "Failed to cancel consumer", slog.String("queue", c.queue), logger.Err(err))
+	}
+	drained := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+		logger.Info(ctx, "RabbitMQ consumer drained", slog.String("queue", c.queue))
+	case <-time.After(c.opts.DrainTimeout):
+		logger.Warn(ctx, "RabbitMQ consumer drain timed out, unacked messages will be redelivered", slog.String("queue", c.queue))
+	}
+	return true, nil
+}
+
+// declare 声明主队列、绑定、重试队列与死信队列，均为幂等操作
+func (c *Consumer) declare(ch *amqp.Channel) error {
+	if _, err := declareQueue(ch, c.queue); err != nil {
+		return err
+	}
+	for _, key := range c.opts.RoutingKeys {
+		if err := ch.QueueBind(c.queue, key, c.client.cfg.Exchange, false, nil); err != nil {
+			return fmt.Errorf("failed to bind %s to %s: %w", c.queue, key, err)
+		}
+	}
+	for _, rq := range c.retryQueues {
+		if _, err := ch.QueueDeclare(rq.name, true, false, false, false, amqp.Table{
+			"x-message-ttl":             rq.delay.Milliseconds(),
+			"x-dead-letter-exchange":    "",
+			"x-dead-letter-routing-key": c.queue,
+		}); err != nil {
+			return fmt.Errorf("failed to declare queue %s: %w", rq.name, err)
+		}
+	}
+	if _, err := declareQueue(ch, c.deadLetter); err != nil {
+		return err
+	}
+	return nil
+}
+
+// handle 处理一条消息并确认，失败时转入重试或死信队列
+func (c *Consumer) handle(ctx context.Context, d amqp.Delivery) {
+	start := time.Now()
+	msg := newMessage(d)
+	ctx = tracing.ExtractAMQP(ctx, d.Headers)
+	ctx, span := tracing.Tracer().Start(ctx, "rabbitmq.process "+msg.RoutingKey,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemRabbitmq,
+			semconv.MessagingDestinationName(c.queue),
+			semconv.MessagingRabbitmqDestinationRoutingKey(msg.RoutingKey),
+			semconv.MessagingMessageID(msg.MessageID),
+		),
+	)
+	defer span.End()
+
+	err := c.safeHandle(ctx, msg)
+	if err == nil {
+		c.ack(ctx, d)
+		metrics.ObserveConsume(c.queue, "ack", time.Since(start))
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+
+	var permanent *permanentError
+	target, outcome := "", "dead_letter"
+	if !errors.As(err, &permanent) && msg.Attempt <= c.opts.MaxRetries {
+		target, outcome = c.retryQueue(msg.Attempt).name, "retry"
+	} else {
+		target = c.deadLetter
+	}
+	if perr := c.republish(ctx, d, msg, target, err); perr != nil {
+		// 转发失败时放回主队列立即重新投递，不丢消息
+		logger.Error(ctx, "Failed to republish message, requeueing",
+			slog.String("queue", c.queue), slog.String("target", target), logger.Err(perr))
+		if nerr := d.Nack(false, true); nerr != nil {
+			logger.Warn(ctx, "Failed to nack message", slog.String("queue", c.queue), logger.Err(nerr))
+		}
+		metrics.ObserveConsume(c.queue, "requeue", time.Since(start))
+		return
+	}
+	c.ack(ctx, d)
+	metrics.ObserveConsume(c.queue, outcome, time.Since(start))
+
+	args := []any{
+		slog.String("queue", c.queue),
+		slog.String("routing_key", msg.RoutingKey),
+		slog.String("message_id", msg.MessageID),
+		slog.Int("attempt", msg.Attempt),
+		logger.Err(err),
+	}
+	if outcome == "retry" {
+		logger.Warn(ctx, "Message handling failed, will retry", args...)
+	} else {
+		logger.Error(ctx, "Message handling failed, moved to dead-letter queue", args...)
+	}
+}
+
+// safeHandle 把 Handler 的 panic 转为错误，按普通失败重试
+func (c *Consumer) safeHandle(ctx context.Context, msg *Message) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error(ctx, "Panic recovered in consumer", slog.String("queue", c.queue), slog.Any("panic", r),
+				slog.String("stack", string(debug.Stack())))
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return c.handler(ctx, msg)
+}
+
+// retryQueue 第 attempt 次失败使用的重试队列，超出级数后沿用最长延迟
+func (c *Consumer) retryQueue(attempt int) retryQueue {
+	return c.retryQueues[min(attempt, len(c.retryQueues))-1]
+}
+
+// republish 经默认交换机把消息发到重试或死信队列，记录重试次数、原路由键与错误
+func (c *Consumer) republish(ctx context.Context, d amqp.Delivery, msg *Message, queue string, cause error) error {
+	headers := amqp.Table{}
+	for k, v := range d.Headers {
+		headers[k] = v
+	}
+	headers[headerRetryCount] = int32(msg.Attempt)
+	headers[headerOriginalRoutingKey] = msg.RoutingKey
+	errText := cause.Error()
+	if len(errText) > maxErrorHeaderLength {
+		errText = errText[:maxErrorHeaderLength]
+	}
+	headers[headerLastError] = errText
+
+	return c.client.publishConfirmed(ctx, "", queue, amqp.Publishing{
+		Headers:         headers,
+		ContentType:     d.ContentType,
+		ContentEncoding: d.ContentEncoding,
+		DeliveryMode:    amqp.Persistent,
+		MessageId:       d.MessageId,
+		Timestamp:       d.Timestamp,
+		Type:            d.Type,
+		AppId:           d.AppId,
+		Body:            d.Body,
+	})
+}
+
+func (c *Consumer) ack(ctx context.Context, d amqp.Delivery) {
+	if err := d.Ack(false); err != nil {
+		// Channel 已关闭时消息会被重新投递
+		logger.Warn(ctx, "Failed to ack message", slog.String("queue", c.queue), logger.Err(err))
+	}
+}
+
+func newMessage(d amqp.Delivery) *Message {
+	msg := &Message{
+		RoutingKey:  d.RoutingKey,
+		MessageID:   d.MessageId,
+		ContentType: d.ContentType,
+		Headers:     d.Headers,
+		Body:        d.Body,
+		Attempt:     1,
+	}
+	if key, ok := d.Headers[headerOriginalRoutingKey].(string); ok {
+		msg.RoutingKey = key
+	}
+	switch n := d.Headers[headerRetryCount].(type) {
+	case int32:
+		msg.Attempt = int(n) + 1
+	case int64:
+		msg.Attempt = int(n) + 1
+	}
+	return msg
+}
+
+// MatchTopic 判断路由键是否匹配 topic 绑定：* 匹配一个单词，# 匹配零个或多个单词
+func MatchTopic(pattern, routingKey string) bool {
+	return matchWords(strings.Split(pattern, "."), strings.Split(routingKey, "."))
+}
+
+func matchWords(pattern, words []string) bool {
+	for i, p := range pattern {
+		switch p {
+		case "#":
+			for j := i; j <= len(words); j++ {
+				if matchWords(pattern[i+1:], words[j:]) {
+					return true
+				}
+			}
+			return false
+		case "*":
+			if i >= len(words) {
+				return false
+			}
+		default:
+			if i >= len(words) || p != words[i] {
+				return false
+			}
+		}
+	}
+	return len(pattern) == len(words)
+}
@@ -0,0 +1,135 @@
+package rabbitmq
+
+import (
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+func TestRetryQueues(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   ConsumerOptions
+		delays []time.Duration
+	}{
+		{
+			name:   "defaults",
+			opts:   ConsumerOptions{},
+			delays: []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second},
+		},
+		{
+			name:   "capped by max backoff",
+			opts:   ConsumerOptions{MaxRetries: 5, RetryBackoff: time.Second, MaxRetryBackoff: 3 * time.Second},
+			delays: []time.Duration{time.Second, 2 * time.Second, 3 * time.Second},
+		},
+		{
+			name:   "backoff equals max",
+			opts:   ConsumerOptions{MaxRetries: 3, RetryBackoff: time.Minute, MaxRetryBackoff: time.Minute},
+			delays: []time.Duration{time.Minute},
+		},
+		{
+			name:   "max below backoff falls back to default",
+			opts:   ConsumerOptions{MaxRetries: 2, RetryBackoff: time.Second, MaxRetryBackoff: time.Millisecond},
+			delays: []time.Duration{time.Second, 2 * time.Second},
+		},
+		{
+			name:   "no retries",
+			opts:   ConsumerOptions{MaxRetries: -1},
+			delays: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tt.opts
+			opts.setDefaults()
+			queues := retryQueues("app.q", opts)
+			if len(queues) != len(tt.delays) {
+				t.Fatalf("got %d queues, want %d", len(queues), len(tt.delays))
+			}
+			for i, q := range queues {
+				if q.delay != tt.delays[i] {
+					t.Errorf("queue %d delay = %s, want %s", i, q.delay, tt.delays[i])
+				}
+				if want := "app.q.retry." + tt.delays[i].String(); q.name != want {
+					t.Errorf("queue %d name = %q, want %q", i, q.name, want)
+				}
+			}
+		})
+	}
+}
+
+func TestConsumerRetryQueue(t *testing.T) {
+	opts := ConsumerOptions{MaxRetries: 5, RetryBackoff: time.Second, MaxRetryBackoff: 2 * time.Second}
+	opts.setDefaults()
+	c := &Consumer{retryQueues: retryQueues("q", opts)}
+	tests := []struct {
+		attempt int
+		delay   time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		// 超过重试队列数后一直使用最长的延迟
+		{3, 2 * time.Second},
+		{5, 2 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := c.retryQueue(tt.attempt).delay; got != tt.delay {
+			t.Errorf("retryQueue(%d).delay = %s, want %s", tt.attempt, got, tt.delay)
+		}
+	}
+}
+
+func TestNewMessage(t *testing.T) {
+	tests := []struct {
+		name       string
+		delivery   amqp.Delivery
+		attempt    int
+		routingKey string
+	}{
+		{
+			name:       "first delivery",
+			delivery:   amqp.Delivery{RoutingKey: "user.registered"},
+			attempt:    1,
+			routingKey: "user.registered",
+		},
+		{
+			name: "retried with int32 count",
+			delivery: amqp.Delivery{
+				RoutingKey: "q.retry.1s",
+				Headers:    amqp.Table{headerRetryCount: int32(2), headerOriginalRoutingKey: "user.registered"},
+			},
+			attempt:    3,
+			routingKey: "user.registered",
+		},
+		{
+			name: "retried with int64 count",
+			delivery: amqp.Delivery{
+				RoutingKey: "q.retry.1s",
+				Headers:    amqp.Table{headerRetryCount: int64(1), headerOriginalRoutingKey: "user.updated"},
+			},
+			attempt:    2,
+			routingKey: "user.updated",
+		},
+		{
+			name: "malformed headers are ignored",
+			delivery: amqp.Delivery{
+				RoutingKey: "user.login",
+				Headers:    amqp.Table{headerRetryCount: "2", headerOriginalRoutingKey: 42},
+			},
+			attempt:    1,
+			routingKey: "user.login",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := newMessage(tt.delivery)
+			if msg.Attempt != tt.attempt {
+				t.Errorf("Attempt = %d, want %d", msg.Attempt, tt.attempt)
+			}
+			if msg.RoutingKey != tt.routingKey {
+				t.Errorf("RoutingKey = %q, want %q", msg.RoutingKey, tt.routingKey)
+			}
+		})
+	}
+}
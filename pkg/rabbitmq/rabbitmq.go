@@ -1,95 +1,104 @@
 package rabbitmq
 
 import (
-	"fmt"
-	"log"
+	"context"
+	"errors"
 
 	amqp "github.com/rabbitmq/amqp091-go"
 	"live-stream-platform/pkg/config"
+	"live-stream-platform/pkg/logger"
 )
 
-var (
-	Conn    *amqp.Connection
-	Channel *amqp.Channel
-	cfg     *config.RabbitMQConfig
-)
+// client 进程内共享的客户端，由 Init 创建
+var client *Client
 
-func Init(config *config.RabbitMQConfig) error {
-	cfg = config
-	var err error
+var errNotInitialized = errors.New("rabbitmq not initialized")
 
-	Conn, err = amqp.Dial(cfg.URL)
+func Init(config *config.RabbitMQConfig) error {
+	c, err := NewClient(config)
 	if err != nil {
-		return fmt.Errorf("failed to connect rabbitmq: %w", err)
+		return err
 	}
+	client = c
+	logger.Info(context.Background(), "RabbitMQ connected successfully")
+	return nil
+}
 
-	Channel, err = Conn.Channel()
-	if err != nil {
-		return fmt.Errorf("failed to open channel: %w", err)
+func Close() error {
+	if client == nil {
+		return nil
 	}
+	return client.Close()
+}
 
-	// 声明交换机
-	err = Channel.ExchangeDeclare(
-		cfg.Exchange, // name
-		"topic",      // type
-		true,         // durable
-		false,        // auto-deleted
-		false,        // internal
-		false,        // no-wait
-		nil,          // arguments
-	)
-	if err != nil {
-		return fmt.Errorf("failed to declare exchange: %w", err)
+// Default 返回 Init 创建的客户端
+func Default() *Client {
+	return client
+}
+
+func DeclareQueue(name string) (amqp.Queue, error) {
+	if client == nil {
+		return amqp.Queue{}, errNotInitialized
 	}
+	return client.DeclareQueue(name)
+}
 
-	log.Println("RabbitMQ connected successfully")
-	return nil
+func BindQueue(queueName, routingKey string) error {
+	if client == nil {
+		return errNotInitialized
+	}
+	return client.BindQueue(queueName, routingKey)
 }
 
-func Close() error {
-	if Channel != nil {
-		Channel.Close()
+// Publish 发布消息，没有上游上下文时使用
+func Publish(routingKey string, body []byte) error {
+	return PublishContext(context.Background(), routingKey, body)
+}
+
+// PublishContext 发布消息，并通过消息头传递 ctx 中的 trace-context；不等待 broker 确认
+func PublishContext(ctx context.Context, routingKey string, body []byte) error {
+	if client == nil {
+		return errNotInitialized
 	}
-	if Conn != nil {
-		return Conn.Close()
+	return client.Publish(ctx, routingKey, body)
+}
+
+// PublishWithConfirm 发布持久消息并等待 broker 确认，用于不能丢失的业务事件
+func PublishWithConfirm(ctx context.Context, routingKey string, body []byte) error {
+	if client == nil {
+		return errNotInitialized
 	}
-	return nil
+	return client.PublishWithConfirm(ctx, routingKey, body)
 }
 
-func GetChannel() *amqp.Channel {
-	return Channel
+// PublishMessage 发布自定义属性的持久消息并等待 broker 确认
+func PublishMessage(ctx context.Context, routingKey string, msg amqp.Publishing) error {
+	if client == nil {
+		return errNotInitialized
+	}
+	return client.PublishMessage(ctx, routingKey, msg)
 }
 
-func DeclareQueue(name string) (amqp.Queue, error) {
-	return Channel.QueueDeclare(
-		fmt.Sprintf("%s_%s", cfg.Prefix, name), // name
-		true,                                   // durable
-		false,                                  // delete when unused
-		false,                                  // exclusive
-		false,                                  // no-wait
-		nil,                                    // arguments
-	)
+// Subscribe 创建临时队列订阅，见 Client.Subscribe
+func Subscribe(routingKeys ...string) (*Subscription, error) {
+	if client == nil {
+		return nil, errNotInitialized
+	}
+	return client.Subscribe(routingKeys...)
 }
 
-func BindQueue(queueName, routingKey string) error {
-	return Channel.QueueBind(
-		fmt.Sprintf("%s_%s", cfg.Prefix, queueName), // queue name
-		routingKey,   // routing key
-		cfg.Exchange, // exchange
-		false,
-		nil,
-	)
+// NewConsumer 创建持久队列消费者，见 Client.NewConsumer
+func NewConsumer(opts ConsumerOptions, handler Handler) (*Consumer, error) {
+	if client == nil {
+		return nil, errNotInitialized
+	}
+	return client.NewConsumer(opts, handler)
 }
 
-func Publish(routingKey string, body []byte) error {
-	return Channel.Publish(
-		cfg.Exchange, // exchange
-		routingKey,   // routing key
-		false,        // mandatory
-		false,        // immediate
-		amqp.Publishing{
-			ContentType: "application/json",
-			Body:        body,
-		},
-	)
+// Ping 检查 RabbitMQ 连接是否可用，重连期间返回错误
+func Ping(ctx context.Context) error {
+	if client == nil {
+		return errNotInitialized
+	}
+	return client.Ping(ctx)
 }
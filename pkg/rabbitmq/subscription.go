@@ -0,0 +1,174 @@
+package rabbitmq
+
+import (
+	"fmt"
+	"sync"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// Subscription 独占、自动删除的临时队列订阅，适用于每个实例都要收到的广播消息（如弹幕）。
+// 使用独立的 Channel，避免消费与发布互相影响。
+// 连接断开重连后，客户端会重新声明临时队列、恢复全部绑定并继续投递到同一个 Deliveries 通道。
+type Subscription struct {
+	client *Client
+
+	mu      sync.Mutex
+	keys    map[string]struct{}
+	channel *amqp.Channel
+	queue   string
+
+	deliveries chan amqp.Delivery
+	done       chan struct{}
+	closeOnce  sync.Once
+	forwarders sync.WaitGroup
+}
+
+// Subscribe 创建临时队列并绑定到交换机上的若干路由键
+func (c *Client) Subscribe(routingKeys ...string) (*Subscription, error) {
+	conn, err := c.connection()
+	if err != nil {
+		return nil, err
+	}
+	sub := &Subscription{
+		client:     c,
+		keys:       make(map[string]struct{}, len(routingKeys)),
+		deliveries: make(chan amqp.Delivery),
+		done:       make(chan struct{}),
+	}
+	for _, key := range routingKeys {
+		sub.keys[key] = struct{}{}
+	}
+	// 先登记再启动，启动期间发生的重连也会恢复该订阅
+	c.mu.Lock()
+	c.subs[sub] = struct{}{}
+	c.mu.Unlock()
+	if err := sub.start(conn); err != nil {
+		sub.Close()
+		return nil, err
+	}
+	return sub, nil
+}
+
+// start 在 conn 上声明临时队列、绑定所有路由键并开始消费
+func (s *Subscription) start(conn *amqp.Connection) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// 重连与 Close 并发时，已关闭的订阅不再恢复
+	select {
+	case <-s.done:
+		return nil
+	default:
+	}
+	if s.channel != nil && !s.channel.IsClosed() {
+		return nil
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return fmt.Errorf("failed to open channel: %w", err)
+	}
+	queue, err := ch.QueueDeclare(
+		"",    // name，由服务端生成
+		false, // durable
+		true,  // delete when unused
+		true,  // exclusive
+		false, // no-wait
+		nil,   // arguments
+	)
+	if err != nil {
+		ch.Close()
+		return fmt.Errorf("failed to declare queue: %w", err)
+	}
+	for key := range s.keys {
+		if err := ch.QueueBind(queue.Name, key, s.client.cfg.Exchange, false, nil); err != nil {
+			ch.Close()
+			return fmt.Errorf("failed to bind %s: %w", key, err)
+		}
+	}
+	deliveries, err := ch.Consume(
+		queue.Name, // queue
+		"",         // consumer
+		true,       // auto-ack
+		true,       // exclusive
+		false,      // no-local
+		false,      // no-wait
+		nil,        // args
+	)
+	if err != nil {
+		ch.Close()
+		return fmt.Errorf("failed to consume: %w", err)
+	}
+
+	s.channel = ch
+	s.queue = queue.Name
+	s.forwarders.Add(1)
+	go s.forward(deliveries)
+	return nil
+}
+
+// forward 把当前 Channel 的消息转发到 Deliveries，连接断开时 deliveries 关闭，等待重连后的新 Channel
+func (s *Subscription) forward(deliveries <-chan amqp.Delivery) {
+	defer s.forwarders.Done()
+	for delivery := range deliveries {
+		select {
+		case s.deliveries <- delivery:
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// Bind 增加路由键绑定。连接断开时只记录，重连后生效
+func (s *Subscription) Bind(routingKey string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[routingKey] = struct{}{}
+	if s.channel == nil || s.channel.IsClosed() {
+		return nil
+	}
+	if err := s.channel.QueueBind(s.queue, routingKey, s.client.cfg.Exchange, false, nil); err != nil {
+		delete(s.keys, routingKey)
+		return fmt.Errorf("failed to bind %s: %w", routingKey, err)
+	}
+	return nil
+}
+
+// Unbind 解除路由键绑定
+func (s *Subscription) Unbind(routingKey string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.keys, routingKey)
+	if s.channel == nil || s.channel.IsClosed() {
+		return nil
+	}
+	if err := s.channel.QueueUnbind(s.queue, routingKey, s.client.cfg.Exchange, nil); err != nil {
+		return fmt.Errorf("failed to unbind %s: %w", routingKey, err)
+	}
+	return nil
+}
+
+// Deliveries 消息通道，重连期间保持打开，Close 后关闭
+func (s *Subscription) Deliveries() <-chan amqp.Delivery {
+	return s.deliveries
+}
+
+// Close 关闭订阅，临时队列随之删除
+func (s *Subscription) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		s.client.mu.Lock()
+		delete(s.client.subs, s)
+		s.client.mu.Unlock()
+
+		close(s.done)
+		s.mu.Lock()
+		if s.channel != nil && !s.channel.IsClosed() {
+			err = s.channel.Close()
+		}
+		s.mu.Unlock()
+		s.forwarders.Wait()
+		close(s.deliveries)
+	})
+	return err
+}
@@ -0,0 +1,40 @@
+package rabbitmq
+
+import "testing"
+
+func TestMatchTopic(t *testing.T) {
+	tests := []struct {
+		pattern    string
+		routingKey string
+		want       bool
+	}{
+		{"user.registered", "user.registered", true},
+		{"user.registered", "user.updated", false},
+		{"user.registered", "user.registered.v2", false},
+		{"user.*", "user.login", true},
+		{"user.*", "user", false},
+		{"user.*", "user.login.failed", false},
+		{"*.login", "user.login", true},
+		{"*", "user", true},
+		{"user.#", "user", true},
+		{"user.#", "user.login", true},
+		{"user.#", "user.login.failed", true},
+		{"user.#", "room.started", false},
+		{"#", "", true},
+		{"#", "room.live.started", true},
+		{"#.started", "room.live.started", true},
+		{"#.started", "started", true},
+		{"#.started", "room.live.stopped", false},
+		{"room.#.started", "room.started", true},
+		{"room.#.started", "room.live.hd.started", true},
+		{"room.#.started", "room.live.stopped", false},
+		{"#.*", "user", true},
+		{"*.#.*", "user.login", true},
+		{"*.#.*", "user", false},
+	}
+	for _, tt := range tests {
+		if got := MatchTopic(tt.pattern, tt.routingKey); got != tt.want {
+			t.Errorf("MatchTopic(%q, %q) = %v, want %v", tt.pattern, tt.routingKey, got, tt.want)
+		}
+	}
+}
@@ -2,11 +2,14 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
 
 	"github.com/redis/go-redis/v9"
 	"live-stream-platform/pkg/config"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/pkg/metrics"
+	"live-stream-platform/pkg/tracing"
 )
 
 var Client *redis.Client
@@ -17,13 +20,15 @@ func Init(cfg *config.RedisConfig) error {
 		Password: cfg.Password,
 		DB:       cfg.DB,
 	})
+	metrics.InstrumentRedis(Client)
+	tracing.InstrumentRedis(Client)
 
 	ctx := context.Background()
 	if err := Client.Ping(ctx).Err(); err != nil {
 		return fmt.Errorf("failed to connect redis: %w", err)
 	}
 
-	log.Println("Redis connected successfully")
+	logger.Info(ctx, "Redis connected successfully")
 	return nil
 }
 
@@ -37,3 +42,11 @@ func Close() error {
 func GetClient() *redis.Client {
 	return Client
 }
+
+// Ping 检查 Redis 连接是否可用
+func Ping(ctx context.Context) error {
+	if Client == nil {
+		return errors.New("redis not initialized")
+	}
+	return Client.Ping(ctx).Err()
+}
@@ -0,0 +1,34 @@
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+const (
+	// Header HTTP 请求头
+	Header = "X-Request-ID"
+	// MetadataKey gRPC metadata 键（必须小写）
+	MetadataKey = "x-request-id"
+)
+
+type ctxKey struct{}
+
+// New 生成请求 ID
+func New() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// NewContext 将请求 ID 写入上下文
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// FromContext 从上下文中获取请求 ID，不存在时返回空串
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(ctxKey{}).(string)
+	return id
+}
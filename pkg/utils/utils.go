@@ -1,9 +1,9 @@
 package utils
 
 import (
+	"crypto/rand"
 	"encoding/hex"
 	"golang.org/x/crypto/bcrypt"
-	"math/rand"
 	"net/mail"
 	"regexp"
 )
@@ -20,11 +20,11 @@ func CheckPassword(password, hash string) bool {
 	return err == nil
 }
 
-// GenerateRandomString 生成随机字符串
+// GenerateRandomString 生成随机字符串（加密安全，可用于密钥）
 func GenerateRandomString(length int) (string, error) {
 	bytes := make([]byte, length)
 	if _, err := rand.Read(bytes); err != nil {
-		return "", nil
+		return "", err
 	}
 	return hex.EncodeToString(bytes)[:length], nil
 }
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc/reflection"
+	adminPb "live-stream-platform/gen/proto/admin"
+	roomPb "live-stream-platform/gen/proto/room"
+	userPb "live-stream-platform/gen/proto/user"
+	"live-stream-platform/pkg/config"
+	"live-stream-platform/pkg/database"
+	"live-stream-platform/pkg/grpcx"
+	"live-stream-platform/pkg/healthcheck"
+	"live-stream-platform/pkg/jwt"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/pkg/metrics"
+	"live-stream-platform/pkg/tracing"
+	"live-stream-platform/services/admin-service/internal/handler"
+	"live-stream-platform/services/admin-service/internal/repository"
+	"live-stream-platform/services/admin-service/internal/service"
+	"live-stream-platform/services/admin-service/migrations"
+)
+
+// banExpireInterval 检查封禁到期的间隔
+const banExpireInterval = time.Minute
+
+func main() {
+	// 1. 加载配置
+	cfg, err := config.Load()
+	if err != nil {
+		logger.Fatal("Failed to load config", logger.Err(err))
+	}
+	logger.Init("admin-service", &cfg.Log)
+	// 热更新日志级别
+	go config.Watch(context.Background(), config.DefaultWatchInterval, func(c *config.Config) {
+		logger.SetLevel(c.Log.Level)
+	})
+	slog.Info("Starting Admin Service...")
+
+	shutdownTracing, err := tracing.Init("admin-service", &cfg.Tracing)
+	if err != nil {
+		logger.Fatal("Failed to init tracing", logger.Err(err))
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			slog.Error("Failed to flush traces", logger.Err(err))
+		}
+	}()
+
+	// 2. 初始化数据库
+	if err := database.Init(&cfg.Database); err != nil {
+		logger.Fatal("Failed to init database", logger.Err(err))
+	}
+	defer database.Close()
+	slog.Info("Database initialized")
+	if *cfg.Database.AutoMigrate {
+		if err := database.Migrate(context.Background(), "admin-service", migrations.FS); err != nil {
+			logger.Fatal("Failed to migrate database", logger.Err(err))
+		}
+	}
+	jwt.Init(cfg.JWT.Secret)
+
+	// 3. 连接用户服务与直播间服务，操作人权限由本服务校验，下游的特权接口只接受服务令牌
+	userConn, err := grpcx.NewClient(cfg.Services.UserService,
+		grpcx.AsService("admin-service"),
+	)
+	if err != nil {
+		logger.Fatal("Failed to dial user service", logger.Err(err))
+	}
+	defer userConn.Close()
+	roomConn, err := grpcx.NewClient(cfg.Services.RoomService,
+		grpcx.AsService("admin-service"),
+	)
+	if err != nil {
+		logger.Fatal("Failed to dial room service", logger.Err(err))
+	}
+	defer roomConn.Close()
+
+	// 4. 创建依赖实例
+	adminRepo := repository.NewAdminRepository(database.DB)
+	auditRepo := repository.NewAuditLogRepository(database.DB)
+	userClient := userPb.NewUserServiceClient(userConn)
+	adminService := service.NewAdminService(adminRepo, auditRepo,
+		userClient,
+		roomPb.NewRoomServiceClient(roomConn),
+	)
+	health := healthcheck.New(healthcheck.DefaultInterval, adminPb.AdminService_ServiceDesc.ServiceName)
+	health.Add("mysql", database.Ping)
+	adminHandler := handler.NewAdminHandler(adminService, health)
+	slog.Info("Admin service initialized")
+
+	// 5. 定时解封到期用户，周期性检查依赖
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go liftExpiredBans(ctx, adminService)
+	go health.Run(ctx)
+
+	// 6. 创建 gRPC 服务器
+	list, err := net.Listen("tcp", ":"+cfg.Server.Port)
+	if err != nil {
+		logger.Fatal("Failed to listen", logger.Err(err))
+	}
+	grpcServer := grpcx.NewServer(
+		grpcx.WithAuth(grpcx.VerifyToken(userClient, grpcx.DefaultVerifyCacheTTL),
+			adminPb.AdminService_Health_FullMethodName,
+		),
+	)
+	// 7. 注册服务
+	adminPb.RegisterAdminServiceServer(grpcServer, adminHandler)
+	health.Register(grpcServer)
+	reflection.Register(grpcServer)
+
+	// 8. 启动服务
+	metricsServer, err := metrics.Serve(cfg.MetricsPort("admin-service"))
+	if err != nil {
+		logger.Fatal("Failed to serve metrics", logger.Err(err))
+	}
+	defer metricsServer.Close()
+	go func() {
+		slog.Info("Admin service listening", slog.String("port", cfg.Server.Port))
+		if err := grpcServer.Serve(list); err != nil {
+			logger.Fatal("Failed to serve", logger.Err(err))
+		}
+	}()
+
+	// 9. 优雅关停
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+	slog.Info("Shutting down Admin Service...")
+	health.Drain(healthcheck.DefaultDrainDelay)
+	cancel()
+	grpcServer.GracefulStop()
+	slog.Info("Admin Service stopped")
+}
+
+// liftExpiredBans 周期性解封到期用户
+func liftExpiredBans(ctx context.Context, adminService service.AdminService) {
+	ticker := time.NewTicker(banExpireInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			lifted, err := adminService.LiftExpiredBans(ctx)
+			if err != nil {
+				slog.Error("Failed to lift expired bans", logger.Err(err))
+				continue
+			}
+			if lifted > 0 {
+				slog.Info("Lifted expired bans", slog.Int("count", lifted))
+			}
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+
+	adminPb "live-stream-platform/gen/proto/admin"
+	commonPb "live-stream-platform/gen/proto/common"
+	"live-stream-platform/pkg/errs"
+	"live-stream-platform/pkg/healthcheck"
+	"live-stream-platform/services/admin-service/internal/service"
+)
+
+type AdminHandler struct {
+	adminPb.UnimplementedAdminServiceServer
+	adminService service.AdminService
+	health       *healthcheck.Checker
+}
+
+func NewAdminHandler(adminService service.AdminService, health *healthcheck.Checker) *AdminHandler {
+	return &AdminHandler{
+		adminService: adminService,
+		health:       health,
+	}
+}
+
+// BanUser 封禁用户
+func (h *AdminHandler) BanUser(ctx context.Context, req *adminPb.BanUserRequest) (*commonPb.Response, error) {
+	if err := h.adminService.BanUser(ctx, req.UserId, req.Reason, req.ExpireAt); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &commonPb.Response{
+		Code:    0,
+		Message: "success",
+	}, nil
+}
+
+// UnbanUser 解封用户
+func (h *AdminHandler) UnbanUser(ctx context.Context, req *adminPb.UnbanUserRequest) (*commonPb.Response, error) {
+	if err := h.adminService.UnbanUser(ctx, req.UserId, req.Reason); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &commonPb.Response{
+		Code:    0,
+		Message: "success",
+	}, nil
+}
+
+// ForceEndLive 强制结束直播
+func (h *AdminHandler) ForceEndLive(ctx context.Context, req *adminPb.ForceEndLiveRequest) (*commonPb.Response, error) {
+	if err := h.adminService.ForceEndLive(ctx, req.RoomId, req.Reason); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &commonPb.Response{
+		Code:    0,
+		Message: "success",
+	}, nil
+}
+
+// ListUsers 按条件分页查询用户
+func (h *AdminHandler) ListUsers(ctx context.Context, req *adminPb.ListUsersRequest) (*adminPb.ListUsersResponse, error) {
+	users, page, err := h.adminService.ListUsers(ctx, req)
+	if err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &adminPb.ListUsersResponse{
+		Code:    0,
+		Message: "success",
+		Users:   users,
+		Page:    page,
+	}, nil
+}
+
+// ListAuditLogs 分页查询审计日志
+func (h *AdminHandler) ListAuditLogs(ctx context.Context, req *adminPb.ListAuditLogsRequest) (*adminPb.ListAuditLogsResponse, error) {
+	logs, page, err := h.adminService.ListAuditLogs(ctx, req)
+	if err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &adminPb.ListAuditLogsResponse{
+		Code:    0,
+		Message: "success",
+		Logs:    logs,
+		Page:    page,
+	}, nil
+}
+
+// Health 健康检查，返回各依赖的最近检查结果
+func (h *AdminHandler) Health(ctx context.Context, req *adminPb.HealthRequest) (*adminPb.HealthResponse, error) {
+	return &adminPb.HealthResponse{
+		Status:       h.health.Status(),
+		Dependencies: h.health.Dependencies(),
+	}, nil
+}
@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+// 管理员角色
+const (
+	RoleAdmin     = "admin"     // 管理员，可查看审计日志
+	RoleModerator = "moderator" // 运营/房管，可封禁用户与强制下播
+)
+
+// Admin 管理员
+type Admin struct {
+	UserID    int64     `gorm:"primaryKey;autoIncrement:false" json:"user_id"`
+	Role      string    `gorm:"type:varchar(20);not null" json:"role"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (Admin) TableName() string {
+	return "admins"
+}
+
+// UserBan 封禁记录
+type UserBan struct {
+	ID         int64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID     int64      `gorm:"index;not null" json:"user_id"`
+	OperatorID int64      `gorm:"not null" json:"operator_id"`
+	Reason     string     `gorm:"type:varchar(255)" json:"reason"`
+	ExpireAt   *time.Time `gorm:"index" json:"expire_at"` // 为空表示永久封禁
+	LiftedAt   *time.Time `json:"lifted_at"`              // 解封时间，为空表示仍在封禁中
+	CreatedAt  time.Time  `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (UserBan) TableName() string {
+	return "user_bans"
+}
@@ -0,0 +1,36 @@
+package model
+
+import "time"
+
+// 审计动作
+const (
+	ActionBanUser      = "user.ban"
+	ActionUnbanUser    = "user.unban"
+	ActionForceEndLive = "room.force_end"
+)
+
+// 审计对象类型
+const (
+	TargetUser = "user"
+	TargetRoom = "room"
+)
+
+// SystemOperatorID 系统操作（如封禁到期自动解封）
+const SystemOperatorID int64 = 0
+
+// AuditLog 审计日志，只追加不修改
+type AuditLog struct {
+	ID         int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	OperatorID int64     `gorm:"index;not null" json:"operator_id"`
+	Action     string    `gorm:"type:varchar(50);index;not null" json:"action"`
+	TargetType string    `gorm:"type:varchar(20);index:idx_audit_logs_target,priority:1;not null" json:"target_type"`
+	TargetID   int64     `gorm:"index:idx_audit_logs_target,priority:2;not null" json:"target_id"`
+	Reason     string    `gorm:"type:varchar(255)" json:"reason"`
+	Before     string    `gorm:"type:json" json:"before"`
+	After      string    `gorm:"type:json" json:"after"`
+	CreatedAt  time.Time `gorm:"autoCreateTime;index" json:"created_at"`
+}
+
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"live-stream-platform/services/admin-service/internal/model"
+)
+
+type AdminRepository interface {
+	// Transaction 在同一个 MySQL 事务中执行 fn，封禁记录与审计日志一起提交，fn 内必须使用传入的 repo
+	Transaction(ctx context.Context, fn func(repo AdminRepository, auditRepo AuditLogRepository) error) error
+	GetByUserID(ctx context.Context, userID int64) (*model.Admin, error)
+	CreateBan(ctx context.Context, ban *model.UserBan) error
+	GetActiveBan(ctx context.Context, userID int64) (*model.UserBan, error)
+	// LiftBan 解除封禁，封禁已被解除时返回 false
+	LiftBan(ctx context.Context, id int64) (bool, error)
+	ListExpiredBans(ctx context.Context, now time.Time, limit int) ([]*model.UserBan, error)
+}
+
+type adminRepository struct {
+	db *gorm.DB
+}
+
+func NewAdminRepository(db *gorm.DB) AdminRepository {
+	return &adminRepository{
+		db: db,
+	}
+}
+
+func (ar *adminRepository) Transaction(ctx context.Context, fn func(repo AdminRepository, auditRepo AuditLogRepository) error) error {
+	return ar.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&adminRepository{db: tx}, &auditLogRepository{db: tx})
+	})
+}
+
+func (ar *adminRepository) GetByUserID(ctx context.Context, userID int64) (*model.Admin, error) {
+	var admin model.Admin
+	if err := ar.db.WithContext(ctx).Where("user_id = ?", userID).First(&admin).Error; err != nil {
+		return nil, err
+	}
+	return &admin, nil
+}
+
+func (ar *adminRepository) CreateBan(ctx context.Context, ban *model.UserBan) error {
+	return ar.db.WithContext(ctx).Create(ban).Error
+}
+
+func (ar *adminRepository) GetActiveBan(ctx context.Context, userID int64) (*model.UserBan, error) {
+	var ban model.UserBan
+	if err := ar.db.WithContext(ctx).
+		Where("user_id = ? AND lifted_at IS NULL", userID).
+		Order("id DESC").
+		First(&ban).Error; err != nil {
+		return nil, err
+	}
+	return &ban, nil
+}
+
+func (ar *adminRepository) LiftBan(ctx context.Context, id int64) (bool, error) {
+	result := ar.db.WithContext(ctx).Model(&model.UserBan{}).
+		Where("id = ? AND lifted_at IS NULL", id).
+		Update("lifted_at", time.Now())
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (ar *adminRepository) ListExpiredBans(ctx context.Context, now time.Time, limit int) ([]*model.UserBan, error) {
+	var bans []*model.UserBan
+	if err := ar.db.WithContext(ctx).
+		Where("lifted_at IS NULL AND expire_at IS NOT NULL AND expire_at <= ?", now).
+		Order("expire_at ASC").
+		Limit(limit).
+		Find(&bans).Error; err != nil {
+		return nil, err
+	}
+	return bans, nil
+}
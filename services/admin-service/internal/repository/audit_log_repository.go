@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"live-stream-platform/services/admin-service/internal/model"
+)
+
+// AuditLogRepository 审计日志仓储，只提供写入与查询，不提供修改和删除
+type AuditLogRepository interface {
+	Create(ctx context.Context, log *model.AuditLog) error
+	List(ctx context.Context, filter *AuditLogFilter, offset, limit int) ([]*model.AuditLog, int64, error)
+}
+
+// AuditLogFilter 审计日志查询条件，零值字段不参与过滤
+type AuditLogFilter struct {
+	OperatorID int64
+	Action     string
+	TargetType string
+	TargetID   int64
+}
+
+type auditLogRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditLogRepository(db *gorm.DB) AuditLogRepository {
+	return &auditLogRepository{
+		db: db,
+	}
+}
+
+func (ar *auditLogRepository) Create(ctx context.Context, log *model.AuditLog) error {
+	return ar.db.WithContext(ctx).Create(log).Error
+}
+
+func (ar *auditLogRepository) List(ctx context.Context, filter *AuditLogFilter, offset, limit int) ([]*model.AuditLog, int64, error) {
+	query := ar.db.WithContext(ctx).Model(&model.AuditLog{})
+	if filter != nil {
+		if filter.OperatorID > 0 {
+			query = query.Where("operator_id = ?", filter.OperatorID)
+		}
+		if filter.Action != "" {
+			query = query.Where("action = ?", filter.Action)
+		}
+		if filter.TargetType != "" {
+			query = query.Where("target_type = ?", filter.TargetType)
+		}
+		if filter.TargetID > 0 {
+			query = query.Where("target_id = ?", filter.TargetID)
+		}
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var logs []*model.AuditLog
+	if err := query.Order("id DESC").Offset(offset).Limit(limit).Find(&logs).Error; err != nil {
+		return nil, 0, err
+	}
+	return logs, total, nil
+}
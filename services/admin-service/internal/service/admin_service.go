@@ -0,0 +1,410 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+	adminPb "live-stream-platform/gen/proto/admin"
+	commonPb "live-stream-platform/gen/proto/common"
+	roomPb "live-stream-platform/gen/proto/room"
+	userPb "live-stream-platform/gen/proto/user"
+	"live-stream-platform/pkg/database"
+	"live-stream-platform/pkg/errs"
+	"live-stream-platform/pkg/grpcx"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/services/admin-service/internal/model"
+	"live-stream-platform/services/admin-service/internal/repository"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	// expiredBanBatch 每轮自动解封的最大数量
+	expiredBanBatch = 100
+	// maxReasonLength 与 user_bans.reason、audit_logs.reason 的列宽一致
+	maxReasonLength = 255
+)
+
+// 与 user-service 的用户状态保持一致
+const (
+	userStatusDisabled = 0
+	userStatusNormal   = 1
+)
+
+// AdminService 管理后台服务接口
+type AdminService interface {
+	// BanUser 封禁用户，expireAt 为 0 表示永久
+	BanUser(ctx context.Context, userID int64, reason string, expireAt int64) error
+	// UnbanUser 解封用户
+	UnbanUser(ctx context.Context, userID int64, reason string) error
+	// ForceEndLive 强制结束直播
+	ForceEndLive(ctx context.Context, roomID int64, reason string) error
+	// ListUsers 按条件分页查询用户
+	ListUsers(ctx context.Context, req *adminPb.ListUsersRequest) ([]*commonPb.UserInfo, *commonPb.PageResponse, error)
+	// ListAuditLogs 分页查询审计日志
+	ListAuditLogs(ctx context.Context, req *adminPb.ListAuditLogsRequest) ([]*adminPb.AuditLog, *commonPb.PageResponse, error)
+	// LiftExpiredBans 解封已到期的用户，返回解封数量
+	LiftExpiredBans(ctx context.Context) (int, error)
+}
+
+// adminService 管理后台服务实现
+type adminService struct {
+	adminRepo  repository.AdminRepository
+	auditRepo  repository.AuditLogRepository
+	userClient userPb.UserServiceClient
+	roomClient roomPb.RoomServiceClient
+}
+
+func NewAdminService(adminRepo repository.AdminRepository, auditRepo repository.AuditLogRepository, userClient userPb.UserServiceClient, roomClient roomPb.RoomServiceClient) AdminService {
+	return &adminService{
+		adminRepo:  adminRepo,
+		auditRepo:  auditRepo,
+		userClient: userClient,
+		roomClient: roomClient,
+	}
+}
+
+// BanUser 封禁用户：禁用账号并吊销其全部 Token，记录封禁与审计日志。
+// 先调用 user-service 禁用账号（可重复调用），再在一个本地事务中写入封禁记录与审计日志；
+// 本地事务失败时账号已被禁用但没有封禁记录，重试 BanUser 即可补齐
+func (s *adminService) BanUser(ctx context.Context, userID int64, reason string, expireAt int64) error {
+	if err := checkReason(reason, true); err != nil {
+		return err
+	}
+	// 读主库：先查后写，从库延迟时可能重复封禁
+	ctx = database.WithPrimary(ctx)
+	operatorID, err := s.checkOperator(ctx, model.RoleModerator)
+	if err != nil {
+		return err
+	}
+	if operatorID == userID {
+		return ErrCannotBanSelf
+	}
+	var expireTime *time.Time
+	if expireAt > 0 {
+		t := time.Unix(expireAt, 0)
+		if !t.After(time.Now()) {
+			return invalidParam("expire_at", "invalid expire time")
+		}
+		expireTime = &t
+	}
+	if _, err := s.adminRepo.GetActiveBan(ctx, userID); err == nil {
+		return ErrUserAlreadyBanned
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return errs.Wrap(err, "failed to check ban")
+	}
+
+	before, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	after, err := s.updateUserStatus(ctx, userID, userStatusDisabled)
+	if err != nil {
+		return err
+	}
+	ban := &model.UserBan{
+		UserID:     userID,
+		OperatorID: operatorID,
+		Reason:     reason,
+		ExpireAt:   expireTime,
+	}
+	return s.adminRepo.Transaction(ctx, func(repo repository.AdminRepository, auditRepo repository.AuditLogRepository) error {
+		if err := repo.CreateBan(ctx, ban); err != nil {
+			return errs.Wrap(err, "failed to create ban")
+		}
+		return audit(ctx, auditRepo, operatorID, model.ActionBanUser, model.TargetUser, userID, reason,
+			snapshot{"user": before},
+			snapshot{"user": after, "ban": ban},
+		)
+	})
+}
+
+// UnbanUser 解封用户
+func (s *adminService) UnbanUser(ctx context.Context, userID int64, reason string) error {
+	if err := checkReason(reason, false); err != nil {
+		return err
+	}
+	ctx = database.WithPrimary(ctx)
+	operatorID, err := s.checkOperator(ctx, model.RoleModerator)
+	if err != nil {
+		return err
+	}
+	ban, err := s.adminRepo.GetActiveBan(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrUserNotBanned
+		}
+		return errs.Wrap(err, "failed to get ban")
+	}
+	return s.liftBan(ctx, operatorID, ban, reason)
+}
+
+// ForceEndLive 强制结束直播。room-service 对未在直播的房间同样返回成功，审计日志写入失败时可直接重试
+func (s *adminService) ForceEndLive(ctx context.Context, roomID int64, reason string) error {
+	if err := checkReason(reason, true); err != nil {
+		return err
+	}
+	operatorID, err := s.checkOperator(ctx, model.RoleModerator)
+	if err != nil {
+		return err
+	}
+	before, err := s.getRoom(ctx, roomID)
+	if err != nil {
+		return err
+	}
+	if _, err := s.roomClient.ForceStopLive(ctx, &roomPb.ForceStopLiveRequest{RoomId: roomID}); err != nil {
+		return downstreamError(err, "failed to stop live")
+	}
+	after, err := s.getRoom(ctx, roomID)
+	if err != nil {
+		return err
+	}
+	return audit(ctx, s.auditRepo, operatorID, model.ActionForceEndLive, model.TargetRoom, roomID, reason,
+		snapshot{"room": before},
+		snapshot{"room": after},
+	)
+}
+
+// ListUsers 按条件分页查询用户
+func (s *adminService) ListUsers(ctx context.Context, req *adminPb.ListUsersRequest) ([]*commonPb.UserInfo, *commonPb.PageResponse, error) {
+	if _, err := s.checkOperator(ctx, model.RoleModerator); err != nil {
+		return nil, nil, err
+	}
+	resp, err := s.userClient.ListUsers(ctx, &userPb.ListUsersRequest{
+		Keyword: req.Keyword,
+		Status:  req.Status,
+		Created: req.Created,
+		Page:    req.Page,
+	})
+	if err != nil {
+		return nil, nil, downstreamError(err, "failed to list users")
+	}
+	return resp.Users, resp.Page, nil
+}
+
+// ListAuditLogs 分页查询审计日志，仅管理员可查看
+func (s *adminService) ListAuditLogs(ctx context.Context, req *adminPb.ListAuditLogsRequest) ([]*adminPb.AuditLog, *commonPb.PageResponse, error) {
+	if _, err := s.checkOperator(ctx, model.RoleAdmin); err != nil {
+		return nil, nil, err
+	}
+	pageNum, pageSize := normalizePage(req.Page)
+	logs, total, err := s.auditRepo.List(ctx, &repository.AuditLogFilter{
+		OperatorID: req.FilterOperatorId,
+		Action:     req.Action,
+		TargetType: req.TargetType,
+		TargetID:   req.TargetId,
+	}, int((pageNum-1)*pageSize), int(pageSize))
+	if err != nil {
+		return nil, nil, errs.Wrap(err, "failed to list audit logs")
+	}
+	logInfos := make([]*adminPb.AuditLog, 0, len(logs))
+	for _, l := range logs {
+		logInfos = append(logInfos, &adminPb.AuditLog{
+			Id:         l.ID,
+			OperatorId: l.OperatorID,
+			Action:     l.Action,
+			TargetType: l.TargetType,
+			TargetId:   l.TargetID,
+			Reason:     l.Reason,
+			Before:     l.Before,
+			After:      l.After,
+			CreatedAt:  l.CreatedAt.Unix(),
+		})
+	}
+	return logInfos, &commonPb.PageResponse{
+		Page:     pageNum,
+		PageSize: pageSize,
+		Total:    total,
+	}, nil
+}
+
+// LiftExpiredBans 解封已到期的用户，操作人记为系统
+func (s *adminService) LiftExpiredBans(ctx context.Context) (int, error) {
+	ctx = database.WithPrimary(ctx)
+	bans, err := s.adminRepo.ListExpiredBans(ctx, time.Now(), expiredBanBatch)
+	if err != nil {
+		return 0, errs.Wrap(err, "failed to list expired bans")
+	}
+	lifted := 0
+	for _, ban := range bans {
+		if err := s.liftBan(ctx, model.SystemOperatorID, ban, "ban expired"); err != nil {
+			logger.Error(ctx, "Failed to lift expired ban",
+				slog.Int64("ban_id", ban.ID), slog.Int64("user_id", ban.UserID), logger.Err(err))
+			continue
+		}
+		lifted++
+	}
+	return lifted, nil
+}
+
+// liftBan 先恢复账号（可重复调用），再在一个本地事务中解除封禁并写入审计日志；
+// 本地事务失败时封禁记录仍然有效，重试解封或等待下一轮自动解封即可补齐
+func (s *adminService) liftBan(ctx context.Context, operatorID int64, ban *model.UserBan, reason string) error {
+	before, err := s.getUser(ctx, ban.UserID)
+	if err != nil {
+		return err
+	}
+	after, err := s.updateUserStatus(ctx, ban.UserID, userStatusNormal)
+	if err != nil {
+		return err
+	}
+	return s.adminRepo.Transaction(ctx, func(repo repository.AdminRepository, auditRepo repository.AuditLogRepository) error {
+		lifted, err := repo.LiftBan(ctx, ban.ID)
+		if err != nil {
+			return errs.Wrap(err, "failed to lift ban")
+		}
+		if !lifted {
+			// 并发解封已经写过审计日志
+			return ErrUserNotBanned
+		}
+		return audit(ctx, auditRepo, operatorID, model.ActionUnbanUser, model.TargetUser, ban.UserID, reason,
+			snapshot{"user": before, "ban": ban},
+			snapshot{"user": after},
+		)
+	})
+}
+
+// checkOperator 校验操作人角色并返回操作人 ID，admin 拥有 moderator 的全部权限。
+// 操作人取自调用方的用户令牌，请求中的 operator_id 不可信
+func (s *adminService) checkOperator(ctx context.Context, role string) (int64, error) {
+	claims, ok := grpcx.ClaimsFromContext(ctx)
+	if !ok || claims.IsService() {
+		return 0, ErrPermissionDenied
+	}
+	// 读主库：从库延迟时被撤销的角色仍可能生效
+	admin, err := s.adminRepo.GetByUserID(database.WithPrimary(ctx), claims.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, ErrPermissionDenied
+		}
+		return 0, errs.Wrap(err, "failed to get operator")
+	}
+	if admin.Role != model.RoleAdmin && admin.Role != role {
+		return 0, ErrPermissionDenied
+	}
+	return claims.UserID, nil
+}
+
+func (s *adminService) getUser(ctx context.Context, userID int64) (*commonPb.UserInfo, error) {
+	resp, err := s.userClient.GetUserInfo(ctx, &userPb.GetUserInfoRequest{UserId: userID})
+	if err != nil {
+		return nil, downstreamError(err, "failed to get user")
+	}
+	return resp.User, nil
+}
+
+func (s *adminService) updateUserStatus(ctx context.Context, userID int64, status int32) (*commonPb.UserInfo, error) {
+	resp, err := s.userClient.UpdateUserStatus(ctx, &userPb.UpdateUserStatusRequest{
+		UserId: userID,
+		Status: status,
+	})
+	if err != nil {
+		return nil, downstreamError(err, "failed to update user status")
+	}
+	return resp.User, nil
+}
+
+// downstreamError user-service 与 room-service 通过 gRPC 状态码返回错误，业务错误原样透传，其余错误按内部错误包装
+func downstreamError(err error, msg string) error {
+	if e := errs.FromError(err); e.Kind != errs.KindInternal {
+		return e
+	}
+	return errs.Wrap(err, msg)
+}
+
+// checkReason 操作原因写入 user_bans.reason 与 audit_logs.reason（varchar(255)），
+// 必须在产生任何副作用之前校验，否则写库失败时下游操作已经生效
+func checkReason(reason string, required bool) error {
+	if required && reason == "" {
+		return invalidParam("reason", "reason is required")
+	}
+	if utf8.RuneCountInString(reason) > maxReasonLength {
+		return invalidParam("reason", fmt.Sprintf("reason must be at most %d characters", maxReasonLength))
+	}
+	return nil
+}
+
+func (s *adminService) getRoom(ctx context.Context, roomID int64) (*roomPb.RoomInfo, error) {
+	resp, err := s.roomClient.GetRoom(ctx, &roomPb.GetRoomRequest{RoomId: roomID})
+	if err != nil {
+		return nil, downstreamError(err, "failed to get room")
+	}
+	return resp.Room, nil
+}
+
+// snapshot 审计快照，值为 protobuf 消息或普通结构体
+type snapshot map[string]any
+
+func (sn snapshot) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]json.RawMessage, len(sn))
+	for key, value := range sn {
+		var (
+			data []byte
+			err  error
+		)
+		if msg, ok := value.(proto.Message); ok {
+			data, err = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(msg)
+		} else {
+			data, err = json.Marshal(value)
+		}
+		if err != nil {
+			return nil, err
+		}
+		fields[key] = data
+	}
+	return json.Marshal(fields)
+}
+
+// audit 写入审计日志，与被审计的本地写入使用同一个事务时传入事务内的 auditRepo
+func audit(ctx context.Context, auditRepo repository.AuditLogRepository, operatorID int64, action, targetType string, targetID int64, reason string, before, after snapshot) error {
+	beforeJSON, err := json.Marshal(before)
+	if err != nil {
+		return errs.Wrap(err, "failed to marshal snapshot")
+	}
+	afterJSON, err := json.Marshal(after)
+	if err != nil {
+		return errs.Wrap(err, "failed to marshal snapshot")
+	}
+	if err := auditRepo.Create(ctx, &model.AuditLog{
+		OperatorID: operatorID,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Reason:     reason,
+		Before:     string(beforeJSON),
+		After:      string(afterJSON),
+	}); err != nil {
+		logger.Error(ctx, "Failed to write audit log",
+			slog.Int64("operator_id", operatorID),
+			slog.String("action", action),
+			slog.String("target_type", targetType),
+			slog.Int64("target_id", targetID),
+			logger.Err(err))
+		return errs.Wrap(err, "failed to write audit log")
+	}
+	return nil
+}
+
+func normalizePage(page *commonPb.PageRequest) (int32, int32) {
+	pageNum, pageSize := int32(1), int32(defaultPageSize)
+	if page != nil {
+		if page.Page > 0 {
+			pageNum = page.Page
+		}
+		if page.PageSize > 0 {
+			pageSize = page.PageSize
+		}
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	return pageNum, pageSize
+}
@@ -0,0 +1,17 @@
+package service
+
+import "live-stream-platform/pkg/errs"
+
+// 管理后台错误码（60xxx），对外发布后数值不可再修改
+var (
+	ErrInvalidParams     = errs.InvalidArgument(60001, "INVALID_PARAMS", "invalid parameters")
+	ErrPermissionDenied  = errs.PermissionDenied(60002, "PERMISSION_DENIED", "permission denied")
+	ErrCannotBanSelf     = errs.InvalidArgument(60003, "CANNOT_BAN_SELF", "cannot ban yourself")
+	ErrUserAlreadyBanned = errs.AlreadyExists(60004, "USER_ALREADY_BANNED", "user is already banned")
+	ErrUserNotBanned     = errs.NotFound(60005, "USER_NOT_BANNED", "user is not banned")
+)
+
+// invalidParam 单个字段校验失败
+func invalidParam(field, description string) error {
+	return ErrInvalidParams.WithMessage(description).WithField(field, description)
+}
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS user_bans;
+DROP TABLE IF EXISTS admins;
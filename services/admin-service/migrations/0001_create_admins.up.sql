@@ -0,0 +1,19 @@
+CREATE TABLE admins (
+    user_id bigint NOT NULL,
+    role varchar(20) NOT NULL,
+    created_at datetime(3) DEFAULT NULL,
+    PRIMARY KEY (user_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE user_bans (
+    id bigint NOT NULL AUTO_INCREMENT,
+    user_id bigint NOT NULL,
+    operator_id bigint NOT NULL,
+    reason varchar(255) DEFAULT NULL,
+    expire_at datetime(3) DEFAULT NULL COMMENT '为空表示永久封禁',
+    lifted_at datetime(3) DEFAULT NULL COMMENT '为空表示仍在封禁中',
+    created_at datetime(3) DEFAULT NULL,
+    PRIMARY KEY (id),
+    KEY idx_user_bans_user_id (user_id),
+    KEY idx_user_bans_expire_at (expire_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
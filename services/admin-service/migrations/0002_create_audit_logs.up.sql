@@ -0,0 +1,17 @@
+-- 审计日志只追加不修改
+CREATE TABLE audit_logs (
+    id bigint NOT NULL AUTO_INCREMENT,
+    operator_id bigint NOT NULL,
+    action varchar(50) NOT NULL,
+    target_type varchar(20) NOT NULL,
+    target_id bigint NOT NULL,
+    reason varchar(255) DEFAULT NULL,
+    `before` json DEFAULT NULL,
+    `after` json DEFAULT NULL,
+    created_at datetime(3) DEFAULT NULL,
+    PRIMARY KEY (id),
+    KEY idx_audit_logs_operator_id (operator_id),
+    KEY idx_audit_logs_action (action),
+    KEY idx_audit_logs_target (target_type, target_id),
+    KEY idx_audit_logs_created_at (created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
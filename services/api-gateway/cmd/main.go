@@ -1 +1,136 @@
 package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	roomPb "live-stream-platform/gen/proto/room"
+	userPb "live-stream-platform/gen/proto/user"
+	"live-stream-platform/pkg/config"
+	"live-stream-platform/pkg/grpcx"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/pkg/metrics"
+	"live-stream-platform/pkg/rabbitmq"
+	"live-stream-platform/pkg/tracing"
+	"live-stream-platform/services/api-gateway/internal/danmaku"
+	"live-stream-platform/services/api-gateway/internal/handler"
+	"live-stream-platform/services/api-gateway/internal/middleware"
+	"live-stream-platform/services/api-gateway/internal/router"
+)
+
+func main() {
+	// 1. 加载配置
+	cfg, err := config.Load()
+	if err != nil {
+		logger.Fatal("Failed to load config", logger.Err(err))
+	}
+	logger.Init("api-gateway", &cfg.Log)
+	slog.Info("Starting API Gateway...")
+
+	shutdownTracing, err := tracing.Init("api-gateway", &cfg.Tracing)
+	if err != nil {
+		logger.Fatal("Failed to init tracing", logger.Err(err))
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			slog.Error("Failed to flush traces", logger.Err(err))
+		}
+	}()
+
+	// 2. 连接用户服务
+	userConn, err := grpcx.NewClient(cfg.Services.UserService)
+	if err != nil {
+		logger.Fatal("Failed to dial user service", logger.Err(err))
+	}
+	defer userConn.Close()
+	slog.Info("User service client created", slog.String("target", cfg.Services.UserService))
+
+	// 3. 连接直播间服务
+	roomConn, err := grpcx.NewClient(cfg.Services.RoomService)
+	if err != nil {
+		logger.Fatal("Failed to dial room service", logger.Err(err))
+	}
+	defer roomConn.Close()
+	slog.Info("Room service client created", slog.String("target", cfg.Services.RoomService))
+
+	// 4. 初始化 RabbitMQ 并启动弹幕分发
+	if err := rabbitmq.Init(&cfg.RabbitMQ); err != nil {
+		logger.Fatal("Failed to init rabbitmq", logger.Err(err))
+	}
+	defer rabbitmq.Close()
+	sub, err := rabbitmq.Subscribe()
+	if err != nil {
+		logger.Fatal("Failed to subscribe danmaku", logger.Err(err))
+	}
+	defer sub.Close()
+	hub := danmaku.NewHub(sub, cfg.RateLimit.DanmakuInterval)
+	hubCtx, stopHub := context.WithCancel(context.Background())
+	defer stopHub()
+	go hub.Run(hubCtx)
+	// 热更新日志级别与弹幕限流
+	go config.Watch(hubCtx, config.DefaultWatchInterval, func(c *config.Config) {
+		logger.SetLevel(c.Log.Level)
+		hub.SetMinSendInterval(c.RateLimit.DanmakuInterval)
+	})
+	slog.Info("Danmaku hub started")
+
+	// 5. 创建 Handler 与路由
+	hookPrefixes, err := cfg.RTMP.HookAllowedPrefixes()
+	if err != nil {
+		logger.Fatal("Invalid rtmp hook allowlist", logger.Err(err))
+	}
+	if cfg.RTMP.HookSecret == "" && len(hookPrefixes) == 0 {
+		if cfg.Server.Env == config.EnvProduction {
+			logger.Fatal("rtmp.hook_secret or rtmp.hook_allowed_ips must be set in production")
+		}
+		slog.Warn("RTMP hooks are not authenticated, set rtmp.hook_secret or rtmp.hook_allowed_ips")
+	}
+	userClient := userPb.NewUserServiceClient(userConn)
+	roomClient := roomPb.NewRoomServiceClient(roomConn)
+	handlers := &router.Handlers{
+		User:    handler.NewUserHandler(userClient),
+		Room:    handler.NewRoomHandler(roomClient),
+		RTMP:    handler.NewRTMPHandler(roomClient),
+		Danmaku: handler.NewDanmakuHandler(hub, roomClient),
+	}
+	server := &http.Server{
+		Addr:         ":" + cfg.Server.Port,
+		Handler:      router.New(userClient, middleware.HookAuth(cfg.RTMP.HookSecret, hookPrefixes), handlers),
+		ReadTimeout:  time.Duration(cfg.Server.ReadTimeout) * time.Second,
+		WriteTimeout: time.Duration(cfg.Server.WriteTimeout) * time.Second,
+		ErrorLog:     logger.Std(slog.LevelWarn),
+	}
+
+	// 6. 启动 HTTP 服务与指标端点
+	metricsServer, err := metrics.Serve(cfg.MetricsPort("api-gateway"))
+	if err != nil {
+		logger.Fatal("Failed to serve metrics", logger.Err(err))
+	}
+	defer metricsServer.Close()
+	go func() {
+		slog.Info("API Gateway listening", slog.String("port", cfg.Server.Port))
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Fatal("Failed to serve", logger.Err(err))
+		}
+	}()
+
+	// 7. 优雅关停
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+	slog.Info("Shutting down API Gateway...")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil {
+		slog.Error("Failed to shutdown gracefully", logger.Err(err))
+	}
+	slog.Info("API Gateway stopped")
+}
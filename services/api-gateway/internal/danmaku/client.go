@@ -0,0 +1,180 @@
+package danmaku
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gorilla/websocket"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/pkg/utils"
+)
+
+const (
+	// writeWait 单次写超时
+	writeWait = 10 * time.Second
+	// pongWait 等待 pong 的最长时间
+	pongWait = 60 * time.Second
+	// pingPeriod 发送 ping 的周期，必须小于 pongWait
+	pingPeriod = (pongWait * 9) / 10
+	// maxMessageSize 客户端消息最大字节数
+	maxMessageSize = 1024
+	// sendBufferSize 每个连接的发送缓冲
+	sendBufferSize = 256
+	// maxContentLength 弹幕最大字符数
+	maxContentLength = 100
+)
+
+// Client 一个观众的 WebSocket 连接
+type Client struct {
+	hub      *Hub
+	conn     *websocket.Conn
+	roomID   int64
+	userID   int64
+	username string
+	send     chan []byte
+	done     chan struct{}
+	once     sync.Once
+	lastSent time.Time
+}
+
+// incoming 客户端发送的消息
+type incoming struct {
+	Content string `json:"content"`
+}
+
+// Serve 接管连接，阻塞直到连接关闭
+func (h *Hub) Serve(conn *websocket.Conn, roomID, userID int64, username string) {
+	c := &Client{
+		hub:      h,
+		conn:     conn,
+		roomID:   roomID,
+		userID:   userID,
+		username: username,
+		send:     make(chan []byte, sendBufferSize),
+		done:     make(chan struct{}),
+	}
+	if err := h.join(c); err != nil {
+		logger.Error(context.Background(), "Failed to join room", slog.Int64("room_id", roomID), logger.Err(err))
+		conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseInternalServerErr, "join room failed"),
+			time.Now().Add(writeWait))
+		conn.Close()
+		return
+	}
+	go c.writePump()
+	c.readPump()
+}
+
+// trySend 非阻塞写入发送缓冲
+func (c *Client) trySend(payload []byte) bool {
+	select {
+	case <-c.done:
+		return true
+	case c.send <- payload:
+		return true
+	default:
+		return false
+	}
+}
+
+// close 通知写协程关闭连接，可重复调用
+func (c *Client) close() {
+	c.once.Do(func() {
+		close(c.done)
+	})
+}
+
+// readPump 读取客户端弹幕并发布，连接断开时离开房间
+func (c *Client) readPump() {
+	defer func() {
+		c.hub.leave(c)
+		c.close()
+	}()
+	c.conn.SetReadLimit(maxMessageSize)
+	c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				logger.Warn(context.Background(), "Danmaku read error", slog.Int64("room_id", c.roomID), logger.Err(err))
+			}
+			return
+		}
+		var in incoming
+		if err := json.Unmarshal(data, &in); err != nil {
+			c.sendError("invalid message")
+			continue
+		}
+		content := strings.TrimSpace(in.Content)
+		if content == "" || utf8.RuneCountInString(content) > maxContentLength {
+			c.sendError("content must be 1-100 characters")
+			continue
+		}
+		now := time.Now()
+		if now.Sub(c.lastSent) < c.hub.minSendInterval() {
+			c.sendError("sending too fast")
+			continue
+		}
+		c.lastSent = now
+
+		id, _ := utils.GenerateRandomString(16)
+		if err := c.hub.Publish(&Message{
+			Type:      TypeChat,
+			ID:        id,
+			RoomID:    c.roomID,
+			UserID:    c.userID,
+			Username:  c.username,
+			Content:   content,
+			Timestamp: now.UnixMilli(),
+		}); err != nil {
+			logger.Error(context.Background(), "Failed to publish danmaku", slog.Int64("room_id", c.roomID), logger.Err(err))
+			c.sendError("send failed, please retry")
+		}
+	}
+}
+
+// writePump 将发送缓冲写入连接并定期 ping
+func (c *Client) writePump() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+	for {
+		select {
+		case <-c.done:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			c.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+			return
+		case payload := <-c.send:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.TextMessage, payload); err != nil {
+				c.close()
+				return
+			}
+		case <-ticker.C:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				c.close()
+				return
+			}
+		}
+	}
+}
+
+// sendError 向当前连接发送错误提示
+func (c *Client) sendError(message string) {
+	payload, _ := json.Marshal(&Message{
+		Type:    TypeError,
+		Content: message,
+	})
+	c.trySend(payload)
+}
@@ -0,0 +1,198 @@
+package danmaku
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/pkg/rabbitmq"
+)
+
+// 消息类型
+const (
+	TypeChat  = "chat"
+	TypeError = "error"
+)
+
+// Message 弹幕消息
+type Message struct {
+	Type      string `json:"type"`
+	ID        string `json:"id,omitempty"`
+	RoomID    int64  `json:"room_id,omitempty"`
+	UserID    int64  `json:"user_id,omitempty"`
+	Username  string `json:"username,omitempty"`
+	Content   string `json:"content,omitempty"`
+	Timestamp int64  `json:"ts,omitempty"`
+}
+
+// RoutingKey 房间弹幕的路由键
+func RoutingKey(roomID int64) string {
+	return fmt.Sprintf("room.%d.chat", roomID)
+}
+
+// roomIDFromRoutingKey 解析 room.<id>.chat
+func roomIDFromRoutingKey(key string) (int64, bool) {
+	parts := strings.Split(key, ".")
+	if len(parts) != 3 || parts[0] != "room" || parts[2] != "chat" {
+		return 0, false
+	}
+	roomID, err := strconv.ParseInt(parts[1], 10, 64)
+	return roomID, err == nil
+}
+
+// Hub 管理本实例的 WebSocket 连接。
+// 弹幕统一经 RabbitMQ 主题交换机扇出，每个实例只绑定本地有观众的房间，
+// 收到后再分发给本地连接，因此观众连在任意网关实例上都能收到完整弹幕。
+type Hub struct {
+	mu    sync.RWMutex
+	rooms map[int64]*room
+	sub   *rabbitmq.Subscription
+	// sendInterval 同一连接发送弹幕的最小间隔（纳秒），可热更新
+	sendInterval atomic.Int64
+}
+
+// room 本实例在某个房间的连接。绑定与解绑路由键需要访问 broker，在 mu 之外进行，
+// 期间 pending 非空，同一房间的 join 等待其关闭后重试，其他房间不受影响
+type room struct {
+	clients map[*Client]struct{}
+	pending chan struct{}
+}
+
+func NewHub(sub *rabbitmq.Subscription, sendInterval time.Duration) *Hub {
+	h := &Hub{
+		rooms: make(map[int64]*room),
+		sub:   sub,
+	}
+	h.SetMinSendInterval(sendInterval)
+	return h
+}
+
+// SetMinSendInterval 调整发送弹幕的最小间隔，对已建立的连接立即生效
+func (h *Hub) SetMinSendInterval(d time.Duration) {
+	h.sendInterval.Store(int64(d))
+}
+
+func (h *Hub) minSendInterval() time.Duration {
+	return time.Duration(h.sendInterval.Load())
+}
+
+// Run 消费 RabbitMQ 消息并分发给本地连接，阻塞直到 ctx 结束或订阅关闭
+func (h *Hub) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case delivery, ok := <-h.sub.Deliveries():
+			if !ok {
+				logger.Warn(ctx, "Danmaku subscription closed")
+				return
+			}
+			roomID, ok := roomIDFromRoutingKey(delivery.RoutingKey)
+			if !ok {
+				continue
+			}
+			h.broadcast(roomID, delivery.Body)
+		}
+	}
+}
+
+// Publish 发布弹幕到 RabbitMQ
+func (h *Hub) Publish(msg *Message) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return rabbitmq.Publish(RoutingKey(msg.RoomID), body)
+}
+
+// join 加入房间，本实例第一个观众加入时绑定该房间的路由键
+func (h *Hub) join(c *Client) error {
+	for {
+		h.mu.Lock()
+		r, ok := h.rooms[c.roomID]
+		if ok && r.pending == nil {
+			r.clients[c] = struct{}{}
+			h.mu.Unlock()
+			return nil
+		}
+		if ok {
+			// 该房间正在绑定或解绑，完成后重新判断
+			pending := r.pending
+			h.mu.Unlock()
+			<-pending
+			continue
+		}
+		r = &room{clients: make(map[*Client]struct{}), pending: make(chan struct{})}
+		h.rooms[c.roomID] = r
+		h.mu.Unlock()
+
+		err := h.sub.Bind(RoutingKey(c.roomID))
+
+		h.mu.Lock()
+		close(r.pending)
+		r.pending = nil
+		if err != nil {
+			delete(h.rooms, c.roomID)
+		} else {
+			r.clients[c] = struct{}{}
+		}
+		h.mu.Unlock()
+		return err
+	}
+}
+
+// leave 离开房间，最后一个观众离开时解除绑定
+func (h *Hub) leave(c *Client) {
+	h.mu.Lock()
+	r, ok := h.rooms[c.roomID]
+	if !ok {
+		h.mu.Unlock()
+		return
+	}
+	if _, ok := r.clients[c]; !ok {
+		h.mu.Unlock()
+		return
+	}
+	delete(r.clients, c)
+	if len(r.clients) > 0 {
+		h.mu.Unlock()
+		return
+	}
+	// 解绑完成前保留房间，避免新观众的绑定先于这次解绑到达 broker
+	r.pending = make(chan struct{})
+	h.mu.Unlock()
+
+	err := h.sub.Unbind(RoutingKey(c.roomID))
+
+	h.mu.Lock()
+	delete(h.rooms, c.roomID)
+	close(r.pending)
+	h.mu.Unlock()
+	if err != nil {
+		logger.Warn(context.Background(), "Failed to unbind room", slog.Int64("room_id", c.roomID), logger.Err(err))
+	}
+}
+
+// broadcast 非阻塞地投递到房间内每个连接，发送缓冲已满的慢客户端会被断开，不拖慢整个房间
+func (h *Hub) broadcast(roomID int64, payload []byte) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	r, ok := h.rooms[roomID]
+	if !ok {
+		return
+	}
+	for c := range r.clients {
+		if !c.trySend(payload) {
+			logger.Warn(context.Background(), "Danmaku client is too slow, disconnecting",
+				slog.Int64("user_id", c.userID), slog.Int64("room_id", roomID))
+			c.close()
+		}
+	}
+}
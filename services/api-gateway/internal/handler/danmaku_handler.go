@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gorilla/websocket"
+	roomPb "live-stream-platform/gen/proto/room"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/services/api-gateway/internal/danmaku"
+	"live-stream-platform/services/api-gateway/internal/middleware"
+	"live-stream-platform/services/api-gateway/internal/response"
+)
+
+type DanmakuHandler struct {
+	hub        *danmaku.Hub
+	roomClient roomPb.RoomServiceClient
+	upgrader   websocket.Upgrader
+}
+
+func NewDanmakuHandler(hub *danmaku.Hub, roomClient roomPb.RoomServiceClient) *DanmakuHandler {
+	return &DanmakuHandler{
+		hub:        hub,
+		roomClient: roomClient,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			// 通过 Token 鉴权，不依赖 Cookie，允许跨域连接
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+	}
+}
+
+// Connect 建立弹幕 WebSocket 连接
+func (h *DanmakuHandler) Connect(w http.ResponseWriter, r *http.Request) {
+	roomID, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+	identity, ok := middleware.IdentityFromContext(r.Context())
+	if !ok {
+		response.Fail(w, http.StatusUnauthorized, 1, "unauthenticated")
+		return
+	}
+	_, err := h.roomClient.GetRoom(r.Context(), &roomPb.GetRoomRequest{RoomId: roomID})
+	if err != nil {
+		response.RPCError(w, err)
+		return
+	}
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade 已写入错误响应
+		logger.Warn(r.Context(), "Failed to upgrade websocket", logger.Err(err))
+		return
+	}
+	h.hub.Serve(conn, roomID, identity.UserID, identity.Username)
+}
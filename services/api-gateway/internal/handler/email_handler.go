@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"net/http"
+
+	userPb "live-stream-platform/gen/proto/user"
+	"live-stream-platform/services/api-gateway/internal/middleware"
+	"live-stream-platform/services/api-gateway/internal/response"
+)
+
+// ResendVerificationEmail 重新发送当前用户的邮箱验证邮件
+func (h *UserHandler) ResendVerificationEmail(w http.ResponseWriter, r *http.Request) {
+	identity, ok := middleware.IdentityFromContext(r.Context())
+	if !ok {
+		response.Fail(w, http.StatusUnauthorized, 1, "unauthenticated")
+		return
+	}
+	if _, err := h.userClient.SendVerificationEmail(r.Context(), &userPb.SendVerificationEmailRequest{
+		UserId: identity.UserID,
+	}); err != nil {
+		response.RPCError(w, err)
+		return
+	}
+	response.Success(w, nil)
+}
+
+type verifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+// VerifyEmail 使用邮件中的令牌验证邮箱
+func (h *UserHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	var req verifyEmailRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if _, err := h.userClient.VerifyEmail(r.Context(), &userPb.VerifyEmailRequest{
+		Token: req.Token,
+	}); err != nil {
+		response.RPCError(w, err)
+		return
+	}
+	response.Success(w, nil)
+}
+
+type forgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+// ForgotPassword 发送重置密码邮件，邮箱是否注册都返回成功
+func (h *UserHandler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	var req forgotPasswordRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if _, err := h.userClient.ForgotPassword(r.Context(), &userPb.ForgotPasswordRequest{
+		Email: req.Email,
+	}); err != nil {
+		response.RPCError(w, err)
+		return
+	}
+	response.Success(w, nil)
+}
+
+type resetPasswordRequest struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"new_password"`
+}
+
+// ResetPassword 使用邮件中的令牌重置密码，所有设备需要重新登录
+func (h *UserHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req resetPasswordRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if _, err := h.userClient.ResetPassword(r.Context(), &userPb.ResetPasswordRequest{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	}); err != nil {
+		response.RPCError(w, err)
+		return
+	}
+	response.Success(w, nil)
+}
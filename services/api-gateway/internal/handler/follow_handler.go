@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc"
+	commonPb "live-stream-platform/gen/proto/common"
+	userPb "live-stream-platform/gen/proto/user"
+	"live-stream-platform/services/api-gateway/internal/middleware"
+	"live-stream-platform/services/api-gateway/internal/response"
+)
+
+// FollowItem 关注列表项
+type FollowItem struct {
+	User       *UserProfile `json:"user"`
+	FollowedAt int64        `json:"followed_at"`
+}
+
+// Follow 关注用户
+func (h *UserHandler) Follow(w http.ResponseWriter, r *http.Request) {
+	h.changeFollow(w, r, h.userClient.Follow)
+}
+
+// Unfollow 取消关注
+func (h *UserHandler) Unfollow(w http.ResponseWriter, r *http.Request) {
+	h.changeFollow(w, r, h.userClient.Unfollow)
+}
+
+func (h *UserHandler) changeFollow(w http.ResponseWriter, r *http.Request,
+	call func(ctx context.Context, in *userPb.FollowRequest, opts ...grpc.CallOption) (*commonPb.Response, error)) {
+	targetID, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+	identity, ok := middleware.IdentityFromContext(r.Context())
+	if !ok {
+		response.Fail(w, http.StatusUnauthorized, 1, "unauthenticated")
+		return
+	}
+	_, err := call(r.Context(), &userPb.FollowRequest{
+		UserId:       identity.UserID,
+		TargetUserId: targetID,
+	})
+	if err != nil {
+		response.RPCError(w, err)
+		return
+	}
+	response.Success(w, nil)
+}
+
+type isFollowingRequest struct {
+	UserIDs []int64 `json:"user_ids"`
+}
+
+// IsFollowing 批量查询当前用户是否关注了指定用户
+func (h *UserHandler) IsFollowing(w http.ResponseWriter, r *http.Request) {
+	identity, ok := middleware.IdentityFromContext(r.Context())
+	if !ok {
+		response.Fail(w, http.StatusUnauthorized, 1, "unauthenticated")
+		return
+	}
+	var req isFollowingRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if len(req.UserIDs) > maxBatchUserIDs {
+		response.BadRequest(w, "too many user ids, at most "+strconv.Itoa(maxBatchUserIDs))
+		return
+	}
+	resp, err := h.userClient.IsFollowing(r.Context(), &userPb.IsFollowingRequest{
+		UserId:        identity.UserID,
+		TargetUserIds: req.UserIDs,
+	})
+	if err != nil {
+		response.RPCError(w, err)
+		return
+	}
+	// JSON 对象的键只能是字符串
+	following := make(map[string]bool, len(resp.Following))
+	for id, followed := range resp.Following {
+		following[strconv.FormatInt(id, 10)] = followed
+	}
+	response.Success(w, map[string]any{
+		"following": following,
+	})
+}
+
+// ListFollowers 查询粉丝列表
+func (h *UserHandler) ListFollowers(w http.ResponseWriter, r *http.Request) {
+	h.listFollows(w, r, h.userClient.ListFollowers)
+}
+
+// ListFollowing 查询关注列表
+func (h *UserHandler) ListFollowing(w http.ResponseWriter, r *http.Request) {
+	h.listFollows(w, r, h.userClient.ListFollowing)
+}
+
+func (h *UserHandler) listFollows(w http.ResponseWriter, r *http.Request,
+	call func(ctx context.Context, in *userPb.ListFollowsRequest, opts ...grpc.CallOption) (*userPb.ListFollowsResponse, error)) {
+	userID, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+	cursor, ok := queryInt64(w, r, "cursor")
+	if !ok {
+		return
+	}
+	limit, ok := queryInt64(w, r, "limit")
+	if !ok {
+		return
+	}
+	resp, err := call(r.Context(), &userPb.ListFollowsRequest{
+		UserId: userID,
+		Cursor: cursor,
+		Limit:  int32(min(limit, maxBatchUserIDs)),
+	})
+	if err != nil {
+		response.RPCError(w, err)
+		return
+	}
+	items := make([]*FollowItem, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		items = append(items, &FollowItem{
+			User:       toUserProfile(item.User),
+			FollowedAt: item.FollowedAt,
+		})
+	}
+	response.Success(w, map[string]any{
+		"items":       items,
+		"next_cursor": resp.NextCursor,
+	})
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"live-stream-platform/services/api-gateway/internal/response"
+)
+
+// maxBodyBytes 请求体最大字节数
+const maxBodyBytes = 1 << 20
+
+// decodeJSON 解析请求体，失败时直接写入错误响应
+func decodeJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	if r.Body == nil || r.ContentLength == 0 {
+		return true
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodyBytes)
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		response.BadRequest(w, "invalid request body")
+		return false
+	}
+	return true
+}
+
+// pathID 解析路径中的 ID 参数
+func pathID(w http.ResponseWriter, r *http.Request, name string) (int64, bool) {
+	id, err := strconv.ParseInt(r.PathValue(name), 10, 64)
+	if err != nil || id <= 0 {
+		response.BadRequest(w, "invalid "+name)
+		return 0, false
+	}
+	return id, true
+}
+
+// queryInt64 解析查询参数中的整数，缺省时返回 0
+func queryInt64(w http.ResponseWriter, r *http.Request, name string) (int64, bool) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return 0, true
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n < 0 {
+		response.BadRequest(w, "invalid "+name)
+		return 0, false
+	}
+	return n, true
+}
+
+// clientIP 获取客户端 IP，优先取反向代理设置的 X-Forwarded-For / X-Real-IP；
+// 这两个头可被伪造，结果仅用于展示，不可用于鉴权
+func clientIP(r *http.Request) string {
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		ip, _, _ := strings.Cut(forwarded, ",")
+		return strings.TrimSpace(ip)
+	}
+	if ip := r.Header.Get("X-Real-IP"); ip != "" {
+		return ip
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/http"
+
+	roomPb "live-stream-platform/gen/proto/room"
+	"live-stream-platform/services/api-gateway/internal/middleware"
+	"live-stream-platform/services/api-gateway/internal/response"
+)
+
+type RoomHandler struct {
+	roomClient roomPb.RoomServiceClient
+}
+
+func NewRoomHandler(roomClient roomPb.RoomServiceClient) *RoomHandler {
+	return &RoomHandler{
+		roomClient: roomClient,
+	}
+}
+
+// GenerateStreamKey 生成或轮换推流密钥，明文只返回这一次
+func (h *RoomHandler) GenerateStreamKey(w http.ResponseWriter, r *http.Request) {
+	roomID, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+	identity, ok := middleware.IdentityFromContext(r.Context())
+	if !ok {
+		response.Fail(w, http.StatusUnauthorized, 1, "unauthenticated")
+		return
+	}
+	resp, err := h.roomClient.GenerateStreamKey(r.Context(), &roomPb.GenerateStreamKeyRequest{
+		RoomId: roomID,
+		UserId: identity.UserID,
+	})
+	if err != nil {
+		response.RPCError(w, err)
+		return
+	}
+	response.Success(w, map[string]any{
+		"stream_name": resp.StreamName,
+		"stream_key":  resp.StreamKey,
+		// 直接粘贴到 OBS “串流密钥” 中
+		"obs_stream_key": resp.StreamName + "?key=" + resp.StreamKey,
+	})
+}
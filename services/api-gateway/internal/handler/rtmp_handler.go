@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	roomPb "live-stream-platform/gen/proto/room"
+	"live-stream-platform/pkg/errs"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/services/api-gateway/internal/response"
+)
+
+// RTMPHandler 媒体服务器（nginx-rtmp / SRS）HTTP 回调
+//
+// nginx-rtmp: on_publish/on_publish_done 以表单提交 name、addr 以及推流地址上的参数（如 key），
+// 返回 2xx 放行，其他状态码拒绝。
+// SRS: http_hooks 以 JSON 提交 stream、ip、param（如 "?key=xxx"），
+// 返回 HTTP 200 且 code 为 0 放行。
+type RTMPHandler struct {
+	roomClient roomPb.RoomServiceClient
+}
+
+func NewRTMPHandler(roomClient roomPb.RoomServiceClient) *RTMPHandler {
+	return &RTMPHandler{
+		roomClient: roomClient,
+	}
+}
+
+// publishHook 推流回调参数
+type publishHook struct {
+	StreamName string
+	StreamKey  string
+	ClientIP   string
+}
+
+// srsHook SRS 回调请求体
+type srsHook struct {
+	Action string `json:"action"`
+	IP     string `json:"ip"`
+	App    string `json:"app"`
+	Stream string `json:"stream"`
+	Param  string `json:"param"`
+}
+
+// OnPublish 推流开始回调
+func (h *RTMPHandler) OnPublish(w http.ResponseWriter, r *http.Request) {
+	hook, ok := parsePublishHook(w, r)
+	if !ok {
+		hookResult(w, http.StatusBadRequest, "invalid hook request")
+		return
+	}
+	_, err := h.roomClient.AuthorizePublish(r.Context(), &roomPb.AuthorizePublishRequest{
+		StreamName: hook.StreamName,
+		StreamKey:  hook.StreamKey,
+		ClientIp:   hook.ClientIP,
+	})
+	if err != nil {
+		if e := errs.FromError(err); e.Kind == errs.KindInternal {
+			logger.Error(r.Context(), "on_publish: authorize stream failed", slog.String("stream", hook.StreamName), logger.Err(err))
+		} else {
+			logger.Warn(r.Context(), "on_publish: stream rejected",
+				slog.String("stream", hook.StreamName), slog.String("client_ip", hook.ClientIP), slog.String("reason", e.Message))
+		}
+		// 非 2xx 即拒绝，下游内部错误的消息不会返回给媒体服务器
+		response.RPCError(w, err)
+		return
+	}
+	hookResult(w, http.StatusOK, "success")
+}
+
+// OnPublishDone 推流结束回调
+func (h *RTMPHandler) OnPublishDone(w http.ResponseWriter, r *http.Request) {
+	hook, ok := parsePublishHook(w, r)
+	if !ok {
+		hookResult(w, http.StatusBadRequest, "invalid hook request")
+		return
+	}
+	_, err := h.roomClient.PublishDone(r.Context(), &roomPb.PublishDoneRequest{
+		StreamName: hook.StreamName,
+		StreamKey:  hook.StreamKey,
+	})
+	if err != nil {
+		if e := errs.FromError(err); e.Kind == errs.KindInternal {
+			logger.Error(r.Context(), "on_publish_done: stream failed", slog.String("stream", hook.StreamName), logger.Err(err))
+		} else {
+			logger.Warn(r.Context(), "on_publish_done: stream rejected",
+				slog.String("stream", hook.StreamName), slog.String("reason", e.Message))
+		}
+		response.RPCError(w, err)
+		return
+	}
+	hookResult(w, http.StatusOK, "success")
+}
+
+// parsePublishHook 兼容 SRS（JSON）与 nginx-rtmp（表单）两种回调格式
+func parsePublishHook(w http.ResponseWriter, r *http.Request) (*publishHook, bool) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodyBytes)
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		var req srsHook
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			return nil, false
+		}
+		params, _ := url.ParseQuery(strings.TrimPrefix(req.Param, "?"))
+		return &publishHook{
+			StreamName: req.Stream,
+			StreamKey:  params.Get("key"),
+			ClientIP:   req.IP,
+		}, req.Stream != ""
+	}
+	if err := r.ParseForm(); err != nil {
+		return nil, false
+	}
+	hook := &publishHook{
+		StreamName: r.Form.Get("name"),
+		StreamKey:  r.Form.Get("key"),
+		ClientIP:   r.Form.Get("addr"),
+	}
+	return hook, hook.StreamName != ""
+}
+
+// hookResult 回调响应：nginx-rtmp 只看状态码，SRS 要求 HTTP 200 且 code 为 0
+func hookResult(w http.ResponseWriter, httpStatus int, message string) {
+	code := 0
+	if httpStatus != http.StatusOK {
+		code = 1
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(httpStatus)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"code":    code,
+		"message": message,
+	})
+}
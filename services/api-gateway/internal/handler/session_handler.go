@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"net/http"
+
+	userPb "live-stream-platform/gen/proto/user"
+	"live-stream-platform/services/api-gateway/internal/middleware"
+	"live-stream-platform/services/api-gateway/internal/response"
+)
+
+// SessionInfo 登录会话
+type SessionInfo struct {
+	SessionID  string `json:"session_id"`
+	DeviceName string `json:"device_name"`
+	UserAgent  string `json:"user_agent"`
+	IP         string `json:"ip"`
+	CreatedAt  int64  `json:"created_at"`
+	LastSeenAt int64  `json:"last_seen_at"`
+	// Current 是否为发起请求的会话
+	Current bool `json:"current"`
+}
+
+// ListSessions 查询当前用户的登录会话
+func (h *UserHandler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	identity, ok := middleware.IdentityFromContext(r.Context())
+	if !ok {
+		response.Fail(w, http.StatusUnauthorized, 1, "unauthenticated")
+		return
+	}
+	resp, err := h.userClient.ListSessions(r.Context(), &userPb.ListSessionsRequest{
+		UserId: identity.UserID,
+	})
+	if err != nil {
+		response.RPCError(w, err)
+		return
+	}
+	sessions := make([]*SessionInfo, 0, len(resp.Sessions))
+	for _, session := range resp.Sessions {
+		sessions = append(sessions, &SessionInfo{
+			SessionID:  session.SessionId,
+			DeviceName: session.DeviceName,
+			UserAgent:  session.UserAgent,
+			IP:         session.Ip,
+			CreatedAt:  session.CreatedAt,
+			LastSeenAt: session.LastSeenAt,
+			Current:    session.SessionId == identity.SessionID,
+		})
+	}
+	response.Success(w, map[string]any{
+		"sessions": sessions,
+	})
+}
+
+// RevokeSession 踢下线指定会话
+func (h *UserHandler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	identity, ok := middleware.IdentityFromContext(r.Context())
+	if !ok {
+		response.Fail(w, http.StatusUnauthorized, 1, "unauthenticated")
+		return
+	}
+	_, err := h.userClient.RevokeSession(r.Context(), &userPb.RevokeSessionRequest{
+		UserId:    identity.UserID,
+		SessionId: r.PathValue("id"),
+	})
+	if err != nil {
+		response.RPCError(w, err)
+		return
+	}
+	response.Success(w, nil)
+}
+
+// RevokeOtherSessions 踢下线除当前会话外的所有设备
+func (h *UserHandler) RevokeOtherSessions(w http.ResponseWriter, r *http.Request) {
+	identity, ok := middleware.IdentityFromContext(r.Context())
+	if !ok {
+		response.Fail(w, http.StatusUnauthorized, 1, "unauthenticated")
+		return
+	}
+	_, err := h.userClient.RevokeAllSessions(r.Context(), &userPb.RevokeAllSessionsRequest{
+		UserId:          identity.UserID,
+		ExceptSessionId: identity.SessionID,
+	})
+	if err != nil {
+		response.RPCError(w, err)
+		return
+	}
+	response.Success(w, nil)
+}
@@ -0,0 +1,276 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	commonPb "live-stream-platform/gen/proto/common"
+	userPb "live-stream-platform/gen/proto/user"
+	"live-stream-platform/services/api-gateway/internal/middleware"
+	"live-stream-platform/services/api-gateway/internal/response"
+)
+
+// maxBatchUserIDs 批量查询用户的最大数量
+const maxBatchUserIDs = 100
+
+type UserHandler struct {
+	userClient userPb.UserServiceClient
+}
+
+func NewUserHandler(userClient userPb.UserServiceClient) *UserHandler {
+	return &UserHandler{
+		userClient: userClient,
+	}
+}
+
+// UserProfile 用户公开资料，不含邮箱等隐私字段
+type UserProfile struct {
+	ID             int64  `json:"id"`
+	Username       string `json:"username"`
+	Nickname       string `json:"nickname"`
+	Gender         int32  `json:"gender"`
+	Avatar         string `json:"avatar"`
+	Status         int32  `json:"status"`
+	CreatedAt      int64  `json:"created_at"`
+	FollowerCount  int64  `json:"follower_count"`
+	FollowingCount int64  `json:"following_count"`
+}
+
+// UserInfo 用户本人可见的完整资料
+type UserInfo struct {
+	UserProfile
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+}
+
+func toUserProfile(user *commonPb.UserInfo) *UserProfile {
+	if user == nil {
+		return nil
+	}
+	return &UserProfile{
+		ID:             user.Id,
+		Username:       user.Username,
+		Nickname:       user.Nickname,
+		Gender:         user.Gender,
+		Avatar:         user.Avatar,
+		Status:         user.Status,
+		CreatedAt:      user.CreatedAt,
+		FollowerCount:  user.FollowerCount,
+		FollowingCount: user.FollowingCount,
+	}
+}
+
+func toUserInfo(user *commonPb.UserInfo) *UserInfo {
+	if user == nil {
+		return nil
+	}
+	return &UserInfo{
+		UserProfile:   *toUserProfile(user),
+		Email:         user.Email,
+		EmailVerified: user.EmailVerified,
+	}
+}
+
+type registerRequest struct {
+	Email    string `json:"email"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Nickname string `json:"nickname"`
+	Gender   int32  `json:"gender"`
+}
+
+// Register 用户注册
+func (h *UserHandler) Register(w http.ResponseWriter, r *http.Request) {
+	var req registerRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	resp, err := h.userClient.Register(r.Context(), &userPb.RegisterRequest{
+		Email:    req.Email,
+		Username: req.Username,
+		Password: req.Password,
+		Nickname: req.Nickname,
+		Gender:   req.Gender,
+	})
+	if err != nil {
+		response.RPCError(w, err)
+		return
+	}
+	response.Success(w, map[string]any{
+		"user_id": resp.UserId,
+	})
+}
+
+type loginRequest struct {
+	Username   string `json:"username"`
+	Password   string `json:"password"`
+	DeviceName string `json:"device_name"`
+}
+
+// Login 用户登录
+func (h *UserHandler) Login(w http.ResponseWriter, r *http.Request) {
+	var req loginRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	resp, err := h.userClient.Login(r.Context(), &userPb.LoginRequest{
+		Username:   req.Username,
+		Password:   req.Password,
+		DeviceName: req.DeviceName,
+		UserAgent:  r.UserAgent(),
+		Ip:         clientIP(r),
+	})
+	if err != nil {
+		response.RPCError(w, err)
+		return
+	}
+	response.Success(w, map[string]any{
+		"token":         resp.Token,
+		"refresh_token": resp.RefreshToken,
+		"expires_in":    resp.ExpiresIn,
+		"user":          toUserInfo(resp.User),
+	})
+}
+
+type refreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// RefreshToken 刷新令牌
+func (h *UserHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	var req refreshTokenRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	resp, err := h.userClient.RefreshToken(r.Context(), &userPb.RefreshTokenRequest{
+		RefreshToken: req.RefreshToken,
+	})
+	if err != nil {
+		response.RPCError(w, err)
+		return
+	}
+	response.Success(w, map[string]any{
+		"token":         resp.Token,
+		"refresh_token": resp.RefreshToken,
+		"expires_in":    resp.ExpiresIn,
+	})
+}
+
+// Logout 用户登出，只能注销当前调用方自己的 Token
+func (h *UserHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	identity, ok := middleware.IdentityFromContext(r.Context())
+	if !ok {
+		response.Fail(w, http.StatusUnauthorized, 1, "unauthenticated")
+		return
+	}
+	_, err := h.userClient.Logout(r.Context(), &userPb.LogoutRequest{
+		UserId: identity.UserID,
+		Token:  identity.Token,
+	})
+	if err != nil {
+		response.RPCError(w, err)
+		return
+	}
+	response.Success(w, nil)
+}
+
+// GetUserInfo 获取用户公开资料
+func (h *UserHandler) GetUserInfo(w http.ResponseWriter, r *http.Request) {
+	userID, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+	resp, err := h.userClient.GetUserInfo(r.Context(), &userPb.GetUserInfoRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		response.RPCError(w, err)
+		return
+	}
+	response.Success(w, toUserProfile(resp.User))
+}
+
+// GetMe 获取当前登录用户的完整资料（含邮箱）
+func (h *UserHandler) GetMe(w http.ResponseWriter, r *http.Request) {
+	identity, ok := middleware.IdentityFromContext(r.Context())
+	if !ok {
+		response.Fail(w, http.StatusUnauthorized, 1, "unauthenticated")
+		return
+	}
+	resp, err := h.userClient.GetUserInfo(r.Context(), &userPb.GetUserInfoRequest{
+		UserId: identity.UserID,
+	})
+	if err != nil {
+		response.RPCError(w, err)
+		return
+	}
+	response.Success(w, toUserInfo(resp.User))
+}
+
+type updateUserInfoRequest struct {
+	Nickname string `json:"nickname"`
+	Gender   int32  `json:"gender"`
+	Avatar   string `json:"avatar"`
+}
+
+// UpdateUserInfo 更新用户信息，只允许修改自己的资料
+func (h *UserHandler) UpdateUserInfo(w http.ResponseWriter, r *http.Request) {
+	userID, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+	identity, ok := middleware.IdentityFromContext(r.Context())
+	if !ok {
+		response.Fail(w, http.StatusUnauthorized, 1, "unauthenticated")
+		return
+	}
+	if identity.UserID != userID {
+		response.Fail(w, http.StatusForbidden, 1, "cannot update other user's profile")
+		return
+	}
+	var req updateUserInfoRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	_, err := h.userClient.UpdateUserInfo(r.Context(), &userPb.UpdateUserInfoRequest{
+		UserId:   userID,
+		Nickname: req.Nickname,
+		Gender:   req.Gender,
+		Avatar:   req.Avatar,
+	})
+	if err != nil {
+		response.RPCError(w, err)
+		return
+	}
+	response.Success(w, nil)
+}
+
+type getUsersByIdsRequest struct {
+	UserIDs []int64 `json:"user_ids"`
+}
+
+// GetUsersByIds 批量获取用户公开资料
+func (h *UserHandler) GetUsersByIds(w http.ResponseWriter, r *http.Request) {
+	var req getUsersByIdsRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if len(req.UserIDs) > maxBatchUserIDs {
+		response.BadRequest(w, "too many user ids, at most "+strconv.Itoa(maxBatchUserIDs))
+		return
+	}
+	resp, err := h.userClient.GetUsersByIds(r.Context(), &userPb.GetUsersByIdsRequest{
+		UserIds: req.UserIDs,
+	})
+	if err != nil {
+		response.RPCError(w, err)
+		return
+	}
+	users := make([]*UserProfile, 0, len(resp.Users))
+	for _, user := range resp.Users {
+		users = append(users, toUserProfile(user))
+	}
+	response.Success(w, map[string]any{
+		"users": users,
+	})
+}
@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	userPb "live-stream-platform/gen/proto/user"
+	"live-stream-platform/pkg/grpcx"
+	"live-stream-platform/services/api-gateway/internal/response"
+)
+
+type identityKey struct{}
+
+// Identity 已认证的调用方身份
+type Identity struct {
+	UserID    int64
+	Username  string
+	Token     string
+	SessionID string
+}
+
+// WithIdentity 将身份写入上下文
+func WithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// IdentityFromContext 从上下文中获取身份
+func IdentityFromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(*Identity)
+	return identity, ok && identity != nil
+}
+
+// Auth 校验 Authorization: Bearer <jwt>，通过 UserService.VerifyToken 验证（包含会话吊销检查）
+func Auth(userClient userPb.UserServiceClient) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := BearerToken(r)
+			if token == "" {
+				response.Fail(w, http.StatusUnauthorized, 1, "missing bearer token")
+				return
+			}
+			resp, err := userClient.VerifyToken(r.Context(), &userPb.VerifyTokenRequest{
+				Token: token,
+			})
+			if err != nil {
+				response.RPCError(w, err)
+				return
+			}
+			// 下游 gRPC 调用携带同一令牌，由各服务的鉴权拦截器再次校验
+			ctx := grpcx.ContextWithToken(r.Context(), token)
+			ctx = WithIdentity(ctx, &Identity{
+				UserID:    resp.UserId,
+				Username:  resp.Username,
+				Token:     token,
+				SessionID: resp.SessionId,
+			})
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// BearerToken 从 Authorization 头中提取 Bearer Token；
+// 浏览器无法为 WebSocket 设置请求头，握手请求允许使用 access_token 查询参数
+func BearerToken(r *http.Request) string {
+	auth := r.Header.Get("Authorization")
+	if len(auth) > 7 && strings.EqualFold(auth[:7], "Bearer ") {
+		return strings.TrimSpace(auth[7:])
+	}
+	if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		return r.URL.Query().Get("access_token")
+	}
+	return ""
+}
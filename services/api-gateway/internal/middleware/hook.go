@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net"
+	"net/http"
+	"net/netip"
+
+	"live-stream-platform/services/api-gateway/internal/response"
+)
+
+// HookSecretHeader 媒体服务器回调携带密钥的请求头，无法设置请求头时使用 secret 查询参数
+const HookSecretHeader = "X-Hook-Secret"
+
+// HookAuth 校验媒体服务器回调：配置了密钥时比对密钥，配置了白名单时校验连接来源，二者都配置时都要满足；
+// 都未配置时不做校验，只应出现在本地开发环境
+func HookAuth(secret string, allowed []netip.Prefix) Middleware {
+	return func(next http.Handler) http.Handler {
+		if secret == "" && len(allowed) == 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if secret != "" && !hookSecretMatches(r, secret) {
+				response.Fail(w, http.StatusForbidden, 1, "invalid hook secret")
+				return
+			}
+			if len(allowed) > 0 && !addrAllowed(r.RemoteAddr, allowed) {
+				response.Fail(w, http.StatusForbidden, 1, "hook source not allowed")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func hookSecretMatches(r *http.Request, secret string) bool {
+	got := r.Header.Get(HookSecretHeader)
+	if got == "" {
+		got = r.URL.Query().Get("secret")
+	}
+	return subtle.ConstantTimeCompare([]byte(got), []byte(secret)) == 1
+}
+
+// addrAllowed 按 TCP 连接的对端地址判断，X-Forwarded-For 可被伪造
+func addrAllowed(remoteAddr string, allowed []netip.Prefix) bool {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range allowed {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package response
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"live-stream-platform/pkg/errs"
+)
+
+// Response 统一响应结构
+type Response struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+	// Reason 机器可读的错误原因，如 USER_NOT_FOUND
+	Reason string `json:"reason,omitempty"`
+	// Errors 参数校验失败的字段
+	Errors []FieldError `json:"errors,omitempty"`
+	Data   any          `json:"data,omitempty"`
+}
+
+// FieldError 字段校验错误
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// writeJSON 输出 JSON 响应
+func writeJSON(w http.ResponseWriter, httpStatus int, resp Response) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(httpStatus)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// Success 成功响应
+func Success(w http.ResponseWriter, data any) {
+	writeJSON(w, http.StatusOK, Response{
+		Code:    0,
+		Message: "success",
+		Data:    data,
+	})
+}
+
+// Fail 失败响应
+func Fail(w http.ResponseWriter, httpStatus int, code int32, message string) {
+	writeJSON(w, httpStatus, Response{
+		Code:    code,
+		Message: message,
+	})
+}
+
+// BadRequest 请求参数错误
+func BadRequest(w http.ResponseWriter, message string) {
+	Fail(w, http.StatusBadRequest, 1, message)
+}
+
+// RPCError gRPC 调用错误，code 取下游 ErrorInfo 中的数字错误码
+func RPCError(w http.ResponseWriter, err error) {
+	st, _ := status.FromError(err)
+	httpStatus := httpStatusFromCode(st.Code())
+	e := errs.FromError(err)
+	resp := Response{
+		Code:    e.Code,
+		Message: e.Message,
+		Reason:  e.Reason,
+	}
+	if httpStatus >= http.StatusInternalServerError {
+		// 不向客户端暴露下游服务的内部错误
+		resp.Message = http.StatusText(httpStatus)
+	}
+	for _, f := range e.Fields {
+		resp.Errors = append(resp.Errors, FieldError{Field: f.Field, Message: f.Description})
+	}
+	writeJSON(w, httpStatus, resp)
+}
+
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.OutOfRange, codes.FailedPrecondition:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Canceled:
+		return 499
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
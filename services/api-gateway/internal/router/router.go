@@ -0,0 +1,68 @@
+package router
+
+import (
+	"net/http"
+
+	userPb "live-stream-platform/gen/proto/user"
+	"live-stream-platform/services/api-gateway/internal/handler"
+	"live-stream-platform/services/api-gateway/internal/middleware"
+)
+
+// Handlers 网关各模块 Handler
+type Handlers struct {
+	User    *handler.UserHandler
+	Room    *handler.RoomHandler
+	RTMP    *handler.RTMPHandler
+	Danmaku *handler.DanmakuHandler
+}
+
+// New 注册网关路由，hookAuth 校验媒体服务器回调
+func New(userClient userPb.UserServiceClient, hookAuth middleware.Middleware, h *Handlers) http.Handler {
+	mux := http.NewServeMux()
+	auth := middleware.Auth(userClient)
+
+	// 认证
+	mux.HandleFunc("POST /api/v1/auth/register", h.User.Register)
+	mux.HandleFunc("POST /api/v1/auth/login", h.User.Login)
+	mux.HandleFunc("POST /api/v1/auth/refresh", h.User.RefreshToken)
+	mux.Handle("POST /api/v1/auth/logout", auth(http.HandlerFunc(h.User.Logout)))
+	mux.HandleFunc("POST /api/v1/auth/verify-email", h.User.VerifyEmail)
+	mux.Handle("POST /api/v1/auth/verify-email/resend", auth(http.HandlerFunc(h.User.ResendVerificationEmail)))
+	mux.HandleFunc("POST /api/v1/auth/password/forgot", h.User.ForgotPassword)
+	mux.HandleFunc("POST /api/v1/auth/password/reset", h.User.ResetPassword)
+
+	// 用户
+	mux.Handle("GET /api/v1/users/me", auth(http.HandlerFunc(h.User.GetMe)))
+	mux.HandleFunc("GET /api/v1/users/{id}", h.User.GetUserInfo)
+	mux.Handle("PATCH /api/v1/users/{id}", auth(http.HandlerFunc(h.User.UpdateUserInfo)))
+	mux.HandleFunc("POST /api/v1/users/batch", h.User.GetUsersByIds)
+
+	// 关注
+	mux.Handle("POST /api/v1/users/{id}/follow", auth(http.HandlerFunc(h.User.Follow)))
+	mux.Handle("DELETE /api/v1/users/{id}/follow", auth(http.HandlerFunc(h.User.Unfollow)))
+	mux.Handle("POST /api/v1/users/following/check", auth(http.HandlerFunc(h.User.IsFollowing)))
+	mux.HandleFunc("GET /api/v1/users/{id}/followers", h.User.ListFollowers)
+	mux.HandleFunc("GET /api/v1/users/{id}/following", h.User.ListFollowing)
+
+	// 登录会话（设备）
+	mux.Handle("GET /api/v1/sessions", auth(http.HandlerFunc(h.User.ListSessions)))
+	mux.Handle("DELETE /api/v1/sessions/{id}", auth(http.HandlerFunc(h.User.RevokeSession)))
+	mux.Handle("DELETE /api/v1/sessions", auth(http.HandlerFunc(h.User.RevokeOtherSessions)))
+
+	// 直播间
+	mux.Handle("POST /api/v1/rooms/{id}/stream-key", auth(http.HandlerFunc(h.Room.GenerateStreamKey)))
+
+	// 弹幕（WebSocket）
+	mux.Handle("GET /api/v1/rooms/{id}/danmaku", auth(http.HandlerFunc(h.Danmaku.Connect)))
+
+	// 媒体服务器回调（nginx-rtmp / SRS）
+	mux.Handle("POST /api/v1/rtmp/on_publish", hookAuth(http.HandlerFunc(h.RTMP.OnPublish)))
+	mux.Handle("POST /api/v1/rtmp/on_publish_done", hookAuth(http.HandlerFunc(h.RTMP.OnPublishDone)))
+
+	return middleware.Chain(mux,
+		middleware.Tracing,
+		middleware.RequestID,
+		middleware.Recovery,
+		middleware.Logging,
+	)
+}
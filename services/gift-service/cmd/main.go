@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc/reflection"
+	giftPb "live-stream-platform/gen/proto/gift"
+	roomPb "live-stream-platform/gen/proto/room"
+	userPb "live-stream-platform/gen/proto/user"
+	"live-stream-platform/pkg/config"
+	"live-stream-platform/pkg/database"
+	"live-stream-platform/pkg/grpcx"
+	"live-stream-platform/pkg/healthcheck"
+	"live-stream-platform/pkg/jwt"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/pkg/metrics"
+	"live-stream-platform/pkg/tracing"
+	"live-stream-platform/services/gift-service/internal/handler"
+	"live-stream-platform/services/gift-service/internal/repository"
+	"live-stream-platform/services/gift-service/internal/service"
+	"live-stream-platform/services/gift-service/migrations"
+)
+
+func main() {
+	// 1. 加载配置
+	cfg, err := config.Load()
+	if err != nil {
+		logger.Fatal("Failed to load config", logger.Err(err))
+	}
+	logger.Init("gift-service", &cfg.Log)
+	// 热更新日志级别
+	go config.Watch(context.Background(), config.DefaultWatchInterval, func(c *config.Config) {
+		logger.SetLevel(c.Log.Level)
+	})
+	slog.Info("Starting Gift Service...")
+
+	shutdownTracing, err := tracing.Init("gift-service", &cfg.Tracing)
+	if err != nil {
+		logger.Fatal("Failed to init tracing", logger.Err(err))
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			slog.Error("Failed to flush traces", logger.Err(err))
+		}
+	}()
+
+	// 2. 初始化数据库
+	if err := database.Init(&cfg.Database); err != nil {
+		logger.Fatal("Failed to init database", logger.Err(err))
+	}
+	defer database.Close()
+	slog.Info("Database initialized")
+	if *cfg.Database.AutoMigrate {
+		if err := database.Migrate(context.Background(), "gift-service", migrations.FS); err != nil {
+			logger.Fatal("Failed to migrate database", logger.Err(err))
+		}
+	}
+	jwt.Init(cfg.JWT.Secret)
+
+	// 3. 连接直播间服务（查询主播）与用户服务（校验用户会话）
+	userConn, err := grpcx.NewClient(cfg.Services.UserService,
+		grpcx.AsService("gift-service"),
+	)
+	if err != nil {
+		logger.Fatal("Failed to dial user service", logger.Err(err))
+	}
+	defer userConn.Close()
+	roomConn, err := grpcx.NewClient(cfg.Services.RoomService)
+	if err != nil {
+		logger.Fatal("Failed to dial room service", logger.Err(err))
+	}
+	defer roomConn.Close()
+
+	// 4. 创建依赖实例
+	giftRepo := repository.NewGiftRepository(database.DB)
+	walletRepo := repository.NewWalletRepository(database.DB)
+	giftService := service.NewGiftService(giftRepo, walletRepo, roomPb.NewRoomServiceClient(roomConn))
+	health := healthcheck.New(healthcheck.DefaultInterval, giftPb.GiftService_ServiceDesc.ServiceName)
+	health.Add("mysql", database.Ping)
+	healthCtx, stopHealth := context.WithCancel(context.Background())
+	defer stopHealth()
+	go health.Run(healthCtx)
+	giftHandler := handler.NewGiftHandler(giftService, health)
+	slog.Info("Gift service initialized")
+
+	// 5. 创建 gRPC 服务器
+	list, err := net.Listen("tcp", ":"+cfg.Server.Port)
+	if err != nil {
+		logger.Fatal("Failed to listen", logger.Err(err))
+	}
+	grpcServer := grpcx.NewServer(
+		grpcx.WithAuth(grpcx.VerifyToken(userPb.NewUserServiceClient(userConn), grpcx.DefaultVerifyCacheTTL),
+			giftPb.GiftService_ListGifts_FullMethodName,
+			giftPb.GiftService_Health_FullMethodName,
+		),
+	)
+	// 6. 注册服务
+	giftPb.RegisterGiftServiceServer(grpcServer, giftHandler)
+	health.Register(grpcServer)
+	reflection.Register(grpcServer)
+
+	// 7. 启动服务
+	metricsServer, err := metrics.Serve(cfg.MetricsPort("gift-service"))
+	if err != nil {
+		logger.Fatal("Failed to serve metrics", logger.Err(err))
+	}
+	defer metricsServer.Close()
+	go func() {
+		slog.Info("Gift service listening", slog.String("port", cfg.Server.Port))
+		if err := grpcServer.Serve(list); err != nil {
+			logger.Fatal("Failed to serve", logger.Err(err))
+		}
+	}()
+
+	// 8. 优雅关停
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+	slog.Info("Shutting down Gift Service...")
+	health.Drain(healthcheck.DefaultDrainDelay)
+	stopHealth()
+	grpcServer.GracefulStop()
+	slog.Info("Gift Service stopped")
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"context"
+
+	giftPb "live-stream-platform/gen/proto/gift"
+	"live-stream-platform/pkg/errs"
+	"live-stream-platform/pkg/grpcx"
+	"live-stream-platform/pkg/healthcheck"
+	"live-stream-platform/services/gift-service/internal/service"
+)
+
+type GiftHandler struct {
+	giftPb.UnimplementedGiftServiceServer
+	giftService service.GiftService
+	health      *healthcheck.Checker
+}
+
+func NewGiftHandler(giftService service.GiftService, health *healthcheck.Checker) *GiftHandler {
+	return &GiftHandler{
+		giftService: giftService,
+		health:      health,
+	}
+}
+
+// ListGifts 获取礼物列表
+func (h *GiftHandler) ListGifts(ctx context.Context, req *giftPb.ListGiftsRequest) (*giftPb.ListGiftsResponse, error) {
+	gifts, err := h.giftService.ListGifts(ctx)
+	if err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &giftPb.ListGiftsResponse{
+		Code:    0,
+		Message: "success",
+		Gifts:   gifts,
+	}, nil
+}
+
+// GetWallet 获取钱包余额
+func (h *GiftHandler) GetWallet(ctx context.Context, req *giftPb.GetWalletRequest) (*giftPb.GetWalletResponse, error) {
+	if err := grpcx.RequireUser(ctx, req.UserId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	wallet, err := h.giftService.GetWallet(ctx, req.UserId)
+	if err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &giftPb.GetWalletResponse{
+		Code:    0,
+		Message: "success",
+		Wallet:  wallet,
+	}, nil
+}
+
+// Recharge 充值
+func (h *GiftHandler) Recharge(ctx context.Context, req *giftPb.RechargeRequest) (*giftPb.RechargeResponse, error) {
+	if err := grpcx.RequireService(ctx); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	txID, balance, err := h.giftService.Recharge(ctx, req)
+	if err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &giftPb.RechargeResponse{
+		Code:          0,
+		Message:       "success",
+		TransactionId: txID,
+		Balance:       balance,
+	}, nil
+}
+
+// SendGift 赠送礼物
+func (h *GiftHandler) SendGift(ctx context.Context, req *giftPb.SendGiftRequest) (*giftPb.SendGiftResponse, error) {
+	if err := grpcx.RequireUser(ctx, req.SenderId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	txID, balance, err := h.giftService.SendGift(ctx, req)
+	if err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &giftPb.SendGiftResponse{
+		Code:          0,
+		Message:       "success",
+		TransactionId: txID,
+		Balance:       balance,
+	}, nil
+}
+
+// ListLedgerEntries 分页获取账户流水
+func (h *GiftHandler) ListLedgerEntries(ctx context.Context, req *giftPb.ListLedgerEntriesRequest) (*giftPb.ListLedgerEntriesResponse, error) {
+	if err := grpcx.RequireUser(ctx, req.UserId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	entries, page, err := h.giftService.ListLedgerEntries(ctx, req.UserId, req.Page)
+	if err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &giftPb.ListLedgerEntriesResponse{
+		Code:    0,
+		Message: "success",
+		Entries: entries,
+		Page:    page,
+	}, nil
+}
+
+// ReconcileWallet 对账
+func (h *GiftHandler) ReconcileWallet(ctx context.Context, req *giftPb.ReconcileWalletRequest) (*giftPb.ReconcileWalletResponse, error) {
+	if err := grpcx.RequireService(ctx); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	walletBalance, ledgerBalance, err := h.giftService.ReconcileWallet(ctx, req.UserId)
+	if err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &giftPb.ReconcileWalletResponse{
+		Code:          0,
+		Message:       "success",
+		WalletBalance: walletBalance,
+		LedgerBalance: ledgerBalance,
+		Consistent:    walletBalance == ledgerBalance,
+	}, nil
+}
+
+// Health 健康检查，返回各依赖的最近检查结果
+func (h *GiftHandler) Health(ctx context.Context, req *giftPb.HealthRequest) (*giftPb.HealthResponse, error) {
+	return &giftPb.HealthResponse{
+		Status:       h.health.Status(),
+		Dependencies: h.health.Dependencies(),
+	}, nil
+}
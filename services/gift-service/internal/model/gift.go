@@ -0,0 +1,38 @@
+package model
+
+import "time"
+
+// Gift 礼物目录
+type Gift struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	Name      string    `gorm:"type:varchar(50);not null" json:"name"`
+	Price     int64     `gorm:"not null" json:"price"`                      // 单价（金币）
+	Animation string    `gorm:"type:varchar(255)" json:"animation"`         // 动画资源地址
+	Sort      int       `gorm:"default:0" json:"sort"`                      // 排序，越小越靠前
+	Status    int       `gorm:"type:tinyint;default:1;index" json:"status"` // 0-下架 1-上架
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (Gift) TableName() string {
+	return "gifts"
+}
+
+// GiftRecord 送礼记录
+type GiftRecord struct {
+	ID            int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	TransactionID int64     `gorm:"uniqueIndex;not null" json:"transaction_id"`
+	SenderID      int64     `gorm:"index;not null" json:"sender_id"`
+	ReceiverID    int64     `gorm:"index;not null" json:"receiver_id"`
+	RoomID        int64     `gorm:"index;not null" json:"room_id"`
+	GiftID        int64     `gorm:"not null" json:"gift_id"`
+	GiftName      string    `gorm:"type:varchar(50);not null" json:"gift_name"`
+	Count         int       `gorm:"not null" json:"count"`
+	UnitPrice     int64     `gorm:"not null" json:"unit_price"`
+	TotalPrice    int64     `gorm:"not null" json:"total_price"`
+	CreatedAt     time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (GiftRecord) TableName() string {
+	return "gift_records"
+}
@@ -0,0 +1,52 @@
+package model
+
+import "time"
+
+// SystemAccountID 平台系统账户，充值时作为出账方，余额允许为负
+const SystemAccountID int64 = 0
+
+// 交易类型
+const (
+	TxTypeRecharge = "recharge"
+	TxTypeGift     = "gift"
+)
+
+// Wallet 用户钱包，Balance 是流水的汇总缓存，可随时由 ledger_entries 重算
+type Wallet struct {
+	UserID    int64     `gorm:"primaryKey;autoIncrement:false" json:"user_id"`
+	Balance   int64     `gorm:"not null;default:0" json:"balance"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (Wallet) TableName() string {
+	return "wallets"
+}
+
+// LedgerTransaction 记账凭证，一笔交易包含若干借贷分录且分录金额之和为 0
+type LedgerTransaction struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	Type      string    `gorm:"type:varchar(20);not null" json:"type"`
+	BizNo     string    `gorm:"type:varchar(100);uniqueIndex;not null" json:"biz_no"` // 业务幂等号
+	Amount    int64     `gorm:"not null" json:"amount"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (LedgerTransaction) TableName() string {
+	return "ledger_transactions"
+}
+
+// LedgerEntry 记账分录，只追加不修改
+type LedgerEntry struct {
+	ID            int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	TransactionID int64     `gorm:"index;not null" json:"transaction_id"`
+	AccountID     int64     `gorm:"index:idx_ledger_entries_account,priority:1;not null" json:"account_id"`
+	Amount        int64     `gorm:"not null" json:"amount"` // 正数入账，负数出账
+	BalanceAfter  int64     `gorm:"not null" json:"balance_after"`
+	Type          string    `gorm:"type:varchar(20);not null" json:"type"`
+	CreatedAt     time.Time `gorm:"autoCreateTime;index:idx_ledger_entries_account,priority:2" json:"created_at"`
+}
+
+func (LedgerEntry) TableName() string {
+	return "ledger_entries"
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"live-stream-platform/services/gift-service/internal/model"
+)
+
+type GiftRepository interface {
+	GetByID(ctx context.Context, id int64) (*model.Gift, error)
+	ListOnShelf(ctx context.Context) ([]*model.Gift, error)
+}
+
+type giftRepository struct {
+	db *gorm.DB
+}
+
+func NewGiftRepository(db *gorm.DB) GiftRepository {
+	return &giftRepository{
+		db: db,
+	}
+}
+
+func (gr *giftRepository) GetByID(ctx context.Context, id int64) (*model.Gift, error) {
+	var gift model.Gift
+	if err := gr.db.WithContext(ctx).Where("id = ?", id).First(&gift).Error; err != nil {
+		return nil, err
+	}
+	return &gift, nil
+}
+
+func (gr *giftRepository) ListOnShelf(ctx context.Context) ([]*model.Gift, error) {
+	var gifts []*model.Gift
+	if err := gr.db.WithContext(ctx).Where("status = ?", 1).Order("sort ASC, id ASC").Find(&gifts).Error; err != nil {
+		return nil, err
+	}
+	return gifts, nil
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"sort"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"live-stream-platform/services/gift-service/internal/model"
+)
+
+type WalletRepository interface {
+	// Transaction 在同一个 MySQL 事务中执行 fn，fn 内必须使用传入的 repo
+	Transaction(ctx context.Context, fn func(repo WalletRepository) error) error
+	GetByUserID(ctx context.Context, userID int64) (*model.Wallet, error)
+	// LockWallets 按 user_id 升序加行锁，避免并发转账死锁；钱包不存在时先创建
+	LockWallets(ctx context.Context, userIDs ...int64) (map[int64]*model.Wallet, error)
+	UpdateBalance(ctx context.Context, userID, balance int64) error
+	GetTransactionByBizNo(ctx context.Context, bizNo string) (*model.LedgerTransaction, error)
+	// CreateTransaction 写入记账凭证及其分录，分录只追加不修改
+	CreateTransaction(ctx context.Context, tx *model.LedgerTransaction, entries []*model.LedgerEntry) error
+	ListEntriesByTransaction(ctx context.Context, txID int64) ([]*model.LedgerEntry, error)
+	CreateGiftRecord(ctx context.Context, record *model.GiftRecord) error
+	GetGiftRecordByTransaction(ctx context.Context, txID int64) (*model.GiftRecord, error)
+	ListEntries(ctx context.Context, accountID int64, offset, limit int) ([]*model.LedgerEntry, int64, error)
+	SumEntries(ctx context.Context, accountID int64) (int64, error)
+}
+
+type walletRepository struct {
+	db *gorm.DB
+}
+
+func NewWalletRepository(db *gorm.DB) WalletRepository {
+	return &walletRepository{
+		db: db,
+	}
+}
+
+func (wr *walletRepository) Transaction(ctx context.Context, fn func(repo WalletRepository) error) error {
+	return wr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&walletRepository{db: tx})
+	})
+}
+
+func (wr *walletRepository) GetByUserID(ctx context.Context, userID int64) (*model.Wallet, error) {
+	var wallet model.Wallet
+	if err := wr.db.WithContext(ctx).Where("user_id = ?", userID).First(&wallet).Error; err != nil {
+		return nil, err
+	}
+	return &wallet, nil
+}
+
+func (wr *walletRepository) LockWallets(ctx context.Context, userIDs ...int64) (map[int64]*model.Wallet, error) {
+	ids := append([]int64(nil), userIDs...)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	db := wr.db.WithContext(ctx)
+	for _, id := range ids {
+		if err := db.Exec("INSERT IGNORE INTO wallets (user_id, balance, created_at, updated_at) VALUES (?, 0, NOW(), NOW())", id).Error; err != nil {
+			return nil, err
+		}
+	}
+	var wallets []*model.Wallet
+	if err := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id IN ?", ids).
+		Order("user_id ASC").
+		Find(&wallets).Error; err != nil {
+		return nil, err
+	}
+	result := make(map[int64]*model.Wallet, len(wallets))
+	for _, wallet := range wallets {
+		result[wallet.UserID] = wallet
+	}
+	return result, nil
+}
+
+func (wr *walletRepository) UpdateBalance(ctx context.Context, userID, balance int64) error {
+	return wr.db.WithContext(ctx).Model(&model.Wallet{}).Where("user_id = ?", userID).Update("balance", balance).Error
+}
+
+func (wr *walletRepository) GetTransactionByBizNo(ctx context.Context, bizNo string) (*model.LedgerTransaction, error) {
+	var tx model.LedgerTransaction
+	if err := wr.db.WithContext(ctx).Where("biz_no = ?", bizNo).First(&tx).Error; err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
+func (wr *walletRepository) CreateTransaction(ctx context.Context, tx *model.LedgerTransaction, entries []*model.LedgerEntry) error {
+	db := wr.db.WithContext(ctx)
+	if err := db.Create(tx).Error; err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		entry.TransactionID = tx.ID
+	}
+	return db.Create(&entries).Error
+}
+
+func (wr *walletRepository) ListEntriesByTransaction(ctx context.Context, txID int64) ([]*model.LedgerEntry, error) {
+	var entries []*model.LedgerEntry
+	if err := wr.db.WithContext(ctx).Where("transaction_id = ?", txID).Order("id ASC").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (wr *walletRepository) CreateGiftRecord(ctx context.Context, record *model.GiftRecord) error {
+	return wr.db.WithContext(ctx).Create(record).Error
+}
+
+func (wr *walletRepository) GetGiftRecordByTransaction(ctx context.Context, txID int64) (*model.GiftRecord, error) {
+	var record model.GiftRecord
+	if err := wr.db.WithContext(ctx).Where("transaction_id = ?", txID).First(&record).Error; err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+func (wr *walletRepository) ListEntries(ctx context.Context, accountID int64, offset, limit int) ([]*model.LedgerEntry, int64, error) {
+	query := wr.db.WithContext(ctx).Model(&model.LedgerEntry{}).Where("account_id = ?", accountID)
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var entries []*model.LedgerEntry
+	if err := query.Order("id DESC").Offset(offset).Limit(limit).Find(&entries).Error; err != nil {
+		return nil, 0, err
+	}
+	return entries, total, nil
+}
+
+func (wr *walletRepository) SumEntries(ctx context.Context, accountID int64) (int64, error) {
+	var sum int64
+	err := wr.db.WithContext(ctx).Model(&model.LedgerEntry{}).
+		Where("account_id = ?", accountID).
+		Select("COALESCE(SUM(amount), 0)").
+		Scan(&sum).Error
+	return sum, err
+}
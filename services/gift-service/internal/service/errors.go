@@ -0,0 +1,19 @@
+package service
+
+import "live-stream-platform/pkg/errs"
+
+// 礼物服务错误码（30xxx），对外发布后数值不可再修改
+var (
+	ErrInvalidParams       = errs.InvalidArgument(30001, "INVALID_PARAMS", "invalid parameters")
+	ErrGiftNotFound        = errs.NotFound(30002, "GIFT_NOT_FOUND", "gift not found")
+	ErrGiftUnavailable     = errs.InvalidArgument(30003, "GIFT_UNAVAILABLE", "gift is not available")
+	ErrCannotGiftSelf      = errs.InvalidArgument(30004, "CANNOT_GIFT_SELF", "cannot send gift to yourself")
+	ErrRoomNotLive         = errs.InvalidArgument(30005, "ROOM_NOT_LIVE", "room is not live")
+	ErrInsufficientBalance = errs.InvalidArgument(30006, "INSUFFICIENT_BALANCE", "insufficient balance")
+	ErrIdempotencyConflict = errs.AlreadyExists(30007, "IDEMPOTENCY_CONFLICT", "request id was already used with different parameters")
+)
+
+// invalidParam 单个字段校验失败
+func invalidParam(field, description string) error {
+	return ErrInvalidParams.WithMessage(description).WithField(field, description)
+}
@@ -0,0 +1,371 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"gorm.io/gorm"
+	commonPb "live-stream-platform/gen/proto/common"
+	giftPb "live-stream-platform/gen/proto/gift"
+	roomPb "live-stream-platform/gen/proto/room"
+	"live-stream-platform/pkg/database"
+	"live-stream-platform/pkg/errs"
+	"live-stream-platform/pkg/metrics"
+	"live-stream-platform/services/gift-service/internal/model"
+	"live-stream-platform/services/gift-service/internal/repository"
+)
+
+const (
+	defaultPageSize  = 20
+	maxPageSize      = 100
+	maxGiftCount     = 9999
+	maxRechargeCoins = 100000000
+)
+
+// roomStatusLive 直播中，与 room-service 的 model.RoomStatusLive 一致
+const roomStatusLive = 1
+
+// GiftService 礼物服务接口
+type GiftService interface {
+	// ListGifts 获取上架礼物列表
+	ListGifts(ctx context.Context) ([]*giftPb.GiftInfo, error)
+	// GetWallet 获取钱包
+	GetWallet(ctx context.Context, userID int64) (*giftPb.WalletInfo, error)
+	// Recharge 充值，返回交易 ID 与充值后余额
+	Recharge(ctx context.Context, req *giftPb.RechargeRequest) (int64, int64, error)
+	// SendGift 赠送礼物，返回交易 ID 与送礼后余额
+	SendGift(ctx context.Context, req *giftPb.SendGiftRequest) (int64, int64, error)
+	// ListLedgerEntries 分页获取账户流水
+	ListLedgerEntries(ctx context.Context, userID int64, page *commonPb.PageRequest) ([]*giftPb.LedgerEntry, *commonPb.PageResponse, error)
+	// ReconcileWallet 根据流水重算余额，返回钱包余额与流水余额
+	ReconcileWallet(ctx context.Context, userID int64) (int64, int64, error)
+}
+
+// giftService 礼物服务实现
+type giftService struct {
+	giftRepo   repository.GiftRepository
+	walletRepo repository.WalletRepository
+	roomClient roomPb.RoomServiceClient
+}
+
+func NewGiftService(giftRepo repository.GiftRepository, walletRepo repository.WalletRepository, roomClient roomPb.RoomServiceClient) GiftService {
+	return &giftService{
+		giftRepo:   giftRepo,
+		walletRepo: walletRepo,
+		roomClient: roomClient,
+	}
+}
+
+// posting 一笔复式记账：account -> 金额变动，所有变动之和必须为 0
+type posting struct {
+	txType  string
+	bizNo   string
+	amount  int64
+	changes map[int64]int64
+	// verify 重复提交时校验记账之外的业务数据（如送礼记录）与原请求一致，可为空
+	verify func(repo repository.WalletRepository, txID int64) error
+}
+
+// ListGifts 获取上架礼物列表
+func (s *giftService) ListGifts(ctx context.Context) ([]*giftPb.GiftInfo, error) {
+	gifts, err := s.giftRepo.ListOnShelf(ctx)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list gifts")
+	}
+	giftInfos := make([]*giftPb.GiftInfo, 0, len(gifts))
+	for _, gift := range gifts {
+		giftInfos = append(giftInfos, &giftPb.GiftInfo{
+			Id:        gift.ID,
+			Name:      gift.Name,
+			Price:     gift.Price,
+			Animation: gift.Animation,
+		})
+	}
+	return giftInfos, nil
+}
+
+// GetWallet 获取钱包，未开通时余额为 0
+func (s *giftService) GetWallet(ctx context.Context, userID int64) (*giftPb.WalletInfo, error) {
+	if userID <= 0 {
+		return nil, invalidParam("user_id", "invalid user id")
+	}
+	wallet, err := s.walletRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &giftPb.WalletInfo{UserId: userID}, nil
+		}
+		return nil, errs.Wrap(err, "failed to get wallet")
+	}
+	return &giftPb.WalletInfo{
+		UserId:    wallet.UserID,
+		Balance:   wallet.Balance,
+		UpdatedAt: wallet.UpdatedAt.Unix(),
+	}, nil
+}
+
+// Recharge 充值：系统账户出账，用户账户入账
+func (s *giftService) Recharge(ctx context.Context, req *giftPb.RechargeRequest) (int64, int64, error) {
+	if req.UserId <= 0 {
+		return 0, 0, invalidParam("user_id", "invalid user id")
+	}
+	if req.Amount <= 0 || req.Amount > maxRechargeCoins {
+		return 0, 0, invalidParam("amount", "invalid amount")
+	}
+	if req.OrderNo == "" {
+		return 0, 0, invalidParam("order_no", "invalid order no")
+	}
+	return s.post(ctx, req.UserId, &posting{
+		txType: model.TxTypeRecharge,
+		bizNo:  fmt.Sprintf("recharge:%s", req.OrderNo),
+		amount: req.Amount,
+		changes: map[int64]int64{
+			model.SystemAccountID: -req.Amount,
+			req.UserId:            req.Amount,
+		},
+	}, nil)
+}
+
+// SendGift 赠送礼物：在同一个事务中扣减送礼人余额、增加主播余额并写入流水和送礼记录
+func (s *giftService) SendGift(ctx context.Context, req *giftPb.SendGiftRequest) (int64, int64, error) {
+	if req.SenderId <= 0 {
+		return 0, 0, invalidParam("sender_id", "invalid sender id")
+	}
+	if req.Count <= 0 || req.Count > maxGiftCount {
+		return 0, 0, invalidParam("count", fmt.Sprintf("invalid count: 1-%d", maxGiftCount))
+	}
+	if req.RequestId == "" {
+		return 0, 0, invalidParam("request_id", "invalid request id")
+	}
+	gift, err := s.giftRepo.GetByID(ctx, req.GiftId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, 0, ErrGiftNotFound
+		}
+		return 0, 0, errs.Wrap(err, "failed to get gift")
+	}
+	if gift.Status != 1 {
+		return 0, 0, ErrGiftUnavailable
+	}
+	receiverID, err := s.getStreamer(ctx, req.RoomId)
+	if err != nil {
+		return 0, 0, err
+	}
+	if receiverID == req.SenderId {
+		return 0, 0, ErrCannotGiftSelf
+	}
+
+	total := gift.Price * int64(req.Count)
+	// 重复提交返回已有交易，不重复计数
+	created := false
+	txID, balance, err := s.post(ctx, req.SenderId, &posting{
+		txType: model.TxTypeGift,
+		bizNo:  fmt.Sprintf("gift:%d:%s", req.SenderId, req.RequestId),
+		amount: total,
+		changes: map[int64]int64{
+			req.SenderId: -total,
+			receiverID:   total,
+		},
+		verify: func(repo repository.WalletRepository, txID int64) error {
+			record, err := repo.GetGiftRecordByTransaction(ctx, txID)
+			if err != nil {
+				return errs.Wrap(err, "failed to get gift record")
+			}
+			if record.GiftID != gift.ID || record.Count != int(req.Count) || record.RoomID != req.RoomId {
+				return ErrIdempotencyConflict
+			}
+			return nil
+		},
+	}, func(repo repository.WalletRepository, txID int64) error {
+		created = true
+		return repo.CreateGiftRecord(ctx, &model.GiftRecord{
+			TransactionID: txID,
+			SenderID:      req.SenderId,
+			ReceiverID:    receiverID,
+			RoomID:        req.RoomId,
+			GiftID:        gift.ID,
+			GiftName:      gift.Name,
+			Count:         int(req.Count),
+			UnitPrice:     gift.Price,
+			TotalPrice:    total,
+		})
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	if created {
+		metrics.GiftsSent.WithLabelValues(gift.Name).Add(float64(req.Count))
+		metrics.GiftCoins.Add(float64(total))
+	}
+	return txID, balance, nil
+}
+
+// ListLedgerEntries 分页获取账户流水
+func (s *giftService) ListLedgerEntries(ctx context.Context, userID int64, page *commonPb.PageRequest) ([]*giftPb.LedgerEntry, *commonPb.PageResponse, error) {
+	pageNum, pageSize := normalizePage(page)
+	entries, total, err := s.walletRepo.ListEntries(ctx, userID, int((pageNum-1)*pageSize), int(pageSize))
+	if err != nil {
+		return nil, nil, errs.Wrap(err, "failed to list ledger entries")
+	}
+	entryInfos := make([]*giftPb.LedgerEntry, 0, len(entries))
+	for _, entry := range entries {
+		entryInfos = append(entryInfos, &giftPb.LedgerEntry{
+			Id:            entry.ID,
+			TransactionId: entry.TransactionID,
+			AccountId:     entry.AccountID,
+			Amount:        entry.Amount,
+			BalanceAfter:  entry.BalanceAfter,
+			Type:          entry.Type,
+			CreatedAt:     entry.CreatedAt.Unix(),
+		})
+	}
+	return entryInfos, &commonPb.PageResponse{
+		Page:     pageNum,
+		PageSize: pageSize,
+		Total:    total,
+	}, nil
+}
+
+// ReconcileWallet 根据流水重算余额
+func (s *giftService) ReconcileWallet(ctx context.Context, userID int64) (int64, int64, error) {
+	// 读主库：余额与流水若来自延迟不同的从库，会误报不一致
+	ctx = database.WithPrimary(ctx)
+	wallet, err := s.GetWallet(ctx, userID)
+	if err != nil {
+		return 0, 0, err
+	}
+	ledgerBalance, err := s.walletRepo.SumEntries(ctx, userID)
+	if err != nil {
+		return 0, 0, errs.Wrap(err, "failed to sum ledger entries")
+	}
+	return wallet.Balance, ledgerBalance, nil
+}
+
+// post 在一个事务中完成复式记账，返回交易 ID 与 owner 的最新余额；
+// 相同 bizNo 重复提交时直接返回已有交易，保证幂等，但交易内容与本次请求不一致时返回冲突
+func (s *giftService) post(ctx context.Context, owner int64, p *posting, after func(repo repository.WalletRepository, txID int64) error) (int64, int64, error) {
+	var sum int64
+	accounts := make([]int64, 0, len(p.changes))
+	for account, change := range p.changes {
+		sum += change
+		accounts = append(accounts, account)
+	}
+	if sum != 0 {
+		return 0, 0, errs.Internal(errors.New("unbalanced posting"))
+	}
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i] < accounts[j] })
+
+	var txID, balance int64
+	err := s.walletRepo.Transaction(ctx, func(repo repository.WalletRepository) error {
+		wallets, err := repo.LockWallets(ctx, accounts...)
+		if err != nil {
+			return errs.Wrap(err, "failed to lock wallets")
+		}
+		// 加锁后再检查幂等号，同一账户的重复请求在此串行化
+		if existing, err := repo.GetTransactionByBizNo(ctx, p.bizNo); err == nil {
+			entries, err := repo.ListEntriesByTransaction(ctx, existing.ID)
+			if err != nil {
+				return errs.Wrap(err, "failed to get transaction entries")
+			}
+			if !p.matches(existing, entries) {
+				return ErrIdempotencyConflict
+			}
+			if p.verify != nil {
+				if err := p.verify(repo, existing.ID); err != nil {
+					return err
+				}
+			}
+			txID, balance = existing.ID, wallets[owner].Balance
+			return nil
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.Wrap(err, "failed to check transaction")
+		}
+
+		entries := make([]*model.LedgerEntry, 0, len(accounts))
+		for _, account := range accounts {
+			wallet := wallets[account]
+			newBalance := wallet.Balance + p.changes[account]
+			if newBalance < 0 && account != model.SystemAccountID {
+				return ErrInsufficientBalance
+			}
+			entries = append(entries, &model.LedgerEntry{
+				AccountID:    account,
+				Amount:       p.changes[account],
+				BalanceAfter: newBalance,
+				Type:         p.txType,
+			})
+			wallet.Balance = newBalance
+		}
+
+		tx := &model.LedgerTransaction{
+			Type:   p.txType,
+			BizNo:  p.bizNo,
+			Amount: p.amount,
+		}
+		if err := repo.CreateTransaction(ctx, tx, entries); err != nil {
+			return errs.Wrap(err, "failed to create transaction")
+		}
+		for _, account := range accounts {
+			if err := repo.UpdateBalance(ctx, account, wallets[account].Balance); err != nil {
+				return errs.Wrap(err, "failed to update balance")
+			}
+		}
+		if after != nil {
+			if err := after(repo, tx.ID); err != nil {
+				return errs.Wrap(err, "failed to record posting")
+			}
+		}
+		txID, balance = tx.ID, wallets[owner].Balance
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return txID, balance, nil
+}
+
+// matches 已有交易的类型、金额与各账户分录是否与本次记账完全相同
+func (p *posting) matches(tx *model.LedgerTransaction, entries []*model.LedgerEntry) bool {
+	if tx.Type != p.txType || tx.Amount != p.amount || len(entries) != len(p.changes) {
+		return false
+	}
+	for _, entry := range entries {
+		if change, ok := p.changes[entry.AccountID]; !ok || change != entry.Amount {
+			return false
+		}
+	}
+	return true
+}
+
+// getStreamer 获取直播间主播 ID，只能给直播中的房间送礼
+func (s *giftService) getStreamer(ctx context.Context, roomID int64) (int64, error) {
+	resp, err := s.roomClient.GetRoom(ctx, &roomPb.GetRoomRequest{RoomId: roomID})
+	if err != nil {
+		// room-service 通过 gRPC 状态码返回错误，业务错误原样透传
+		if e := errs.FromError(err); e.Kind != errs.KindInternal {
+			return 0, e
+		}
+		return 0, errs.Wrap(err, "failed to get room")
+	}
+	if resp.Room.Status != roomStatusLive {
+		return 0, ErrRoomNotLive
+	}
+	return resp.Room.OwnerId, nil
+}
+
+func normalizePage(page *commonPb.PageRequest) (int32, int32) {
+	pageNum, pageSize := int32(1), int32(defaultPageSize)
+	if page != nil {
+		if page.Page > 0 {
+			pageNum = page.Page
+		}
+		if page.PageSize > 0 {
+			pageSize = page.PageSize
+		}
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	return pageNum, pageSize
+}
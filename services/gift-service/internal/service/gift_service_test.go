@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+
+	"gorm.io/gorm"
+	"live-stream-platform/pkg/errs"
+	"live-stream-platform/services/gift-service/internal/model"
+	"live-stream-platform/services/gift-service/internal/repository"
+)
+
+// ledger 内存中的账本，Transaction 在副本上执行，成功后才提交
+type ledger struct {
+	wallets map[int64]int64
+	txs     []*model.LedgerTransaction
+	entries []*model.LedgerEntry
+}
+
+func (l *ledger) clone() *ledger {
+	return &ledger{
+		wallets: maps.Clone(l.wallets),
+		txs:     slices.Clone(l.txs),
+		entries: slices.Clone(l.entries),
+	}
+}
+
+// fakeWalletRepo 只实现 post 用到的方法，其余方法调用时 panic
+type fakeWalletRepo struct {
+	repository.WalletRepository
+	l *ledger
+}
+
+func (r *fakeWalletRepo) Transaction(_ context.Context, fn func(repo repository.WalletRepository) error) error {
+	l := r.l.clone()
+	if err := fn(&fakeWalletRepo{l: l}); err != nil {
+		return err
+	}
+	*r.l = *l
+	return nil
+}
+
+func (r *fakeWalletRepo) LockWallets(_ context.Context, userIDs ...int64) (map[int64]*model.Wallet, error) {
+	wallets := make(map[int64]*model.Wallet, len(userIDs))
+	for _, id := range userIDs {
+		if _, ok := r.l.wallets[id]; !ok {
+			r.l.wallets[id] = 0
+		}
+		wallets[id] = &model.Wallet{UserID: id, Balance: r.l.wallets[id]}
+	}
+	return wallets, nil
+}
+
+func (r *fakeWalletRepo) UpdateBalance(_ context.Context, userID, balance int64) error {
+	r.l.wallets[userID] = balance
+	return nil
+}
+
+func (r *fakeWalletRepo) GetTransactionByBizNo(_ context.Context, bizNo string) (*model.LedgerTransaction, error) {
+	for _, tx := range r.l.txs {
+		if tx.BizNo == bizNo {
+			return tx, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *fakeWalletRepo) CreateTransaction(_ context.Context, tx *model.LedgerTransaction, entries []*model.LedgerEntry) error {
+	tx.ID = int64(len(r.l.txs) + 1)
+	r.l.txs = append(r.l.txs, tx)
+	for _, entry := range entries {
+		entry.TransactionID = tx.ID
+		r.l.entries = append(r.l.entries, entry)
+	}
+	return nil
+}
+
+func (r *fakeWalletRepo) ListEntriesByTransaction(_ context.Context, txID int64) ([]*model.LedgerEntry, error) {
+	var entries []*model.LedgerEntry
+	for _, entry := range r.l.entries {
+		if entry.TransactionID == txID {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+// checkLedger 校验复式记账的不变量：每笔交易的分录之和为 0，钱包余额等于其分录之和，
+// 且分录上的 BalanceAfter 按顺序累加
+func checkLedger(t *testing.T, l *ledger) {
+	t.Helper()
+	txSums := make(map[int64]int64)
+	balances := make(map[int64]int64)
+	var total int64
+	for _, entry := range l.entries {
+		txSums[entry.TransactionID] += entry.Amount
+		balances[entry.AccountID] += entry.Amount
+		if entry.BalanceAfter != balances[entry.AccountID] {
+			t.Errorf("entry of account %d in tx %d: balance_after = %d, want %d",
+				entry.AccountID, entry.TransactionID, entry.BalanceAfter, balances[entry.AccountID])
+		}
+	}
+	for txID, sum := range txSums {
+		if sum != 0 {
+			t.Errorf("tx %d entries sum to %d, want 0", txID, sum)
+		}
+	}
+	for account, balance := range l.wallets {
+		total += balance
+		if balance != balances[account] {
+			t.Errorf("account %d: wallet balance = %d, ledger balance = %d", account, balance, balances[account])
+		}
+		if balance < 0 && account != model.SystemAccountID {
+			t.Errorf("account %d has negative balance %d", account, balance)
+		}
+	}
+	if total != 0 {
+		t.Errorf("wallet balances sum to %d, want 0", total)
+	}
+}
+
+func recharge(bizNo string, user, amount int64) *posting {
+	return &posting{
+		txType:  model.TxTypeRecharge,
+		bizNo:   bizNo,
+		amount:  amount,
+		changes: map[int64]int64{model.SystemAccountID: -amount, user: amount},
+	}
+}
+
+func transfer(bizNo string, from, to, amount int64) *posting {
+	return &posting{
+		txType:  model.TxTypeGift,
+		bizNo:   bizNo,
+		amount:  amount,
+		changes: map[int64]int64{from: -amount, to: amount},
+	}
+}
+
+func TestPost(t *testing.T) {
+	errConflict := errors.New("verify conflict")
+	// 按顺序在同一个账本上执行
+	steps := []struct {
+		name    string
+		owner   int64
+		posting *posting
+		wantErr error
+		// wantTx 期望的交易 ID，0 表示不检查
+		wantTx      int64
+		wantBalance int64
+		// wantAfter 是否调用了 after（只有新建交易时调用）
+		wantAfter bool
+	}{
+		{name: "recharge", owner: 1, posting: recharge("recharge:o1", 1, 100), wantTx: 1, wantBalance: 100, wantAfter: true},
+		{name: "gift", owner: 1, posting: transfer("gift:1:r1", 1, 2, 30), wantTx: 2, wantBalance: 70, wantAfter: true},
+		{name: "receiver balance", owner: 2, posting: recharge("recharge:o2", 2, 5), wantTx: 3, wantBalance: 35, wantAfter: true},
+		{name: "spend whole balance", owner: 1, posting: transfer("gift:1:r2", 1, 2, 70), wantTx: 4, wantBalance: 0, wantAfter: true},
+		{name: "insufficient balance", owner: 1, posting: transfer("gift:1:r3", 1, 2, 1), wantErr: ErrInsufficientBalance},
+		{name: "replay", owner: 1, posting: transfer("gift:1:r1", 1, 2, 30), wantTx: 2, wantBalance: 0},
+		{name: "replay after failure", owner: 1, posting: recharge("recharge:o3", 1, 10), wantTx: 5, wantBalance: 10, wantAfter: true},
+		{name: "reused biz no with another amount", owner: 1, posting: transfer("gift:1:r1", 1, 2, 31), wantErr: ErrIdempotencyConflict},
+		{name: "reused biz no with another receiver", owner: 1, posting: transfer("gift:1:r1", 1, 3, 30), wantErr: ErrIdempotencyConflict},
+		{name: "reused biz no with another type", owner: 1, posting: &posting{
+			txType: model.TxTypeRecharge, bizNo: "gift:1:r1", amount: 30, changes: map[int64]int64{1: -30, 2: 30},
+		}, wantErr: ErrIdempotencyConflict},
+		{name: "replay rejected by verify", owner: 1, posting: func() *posting {
+			p := transfer("gift:1:r1", 1, 2, 30)
+			p.verify = func(repository.WalletRepository, int64) error { return errConflict }
+			return p
+		}(), wantErr: errConflict},
+		{name: "unbalanced", owner: 1, posting: &posting{
+			txType: model.TxTypeGift, bizNo: "gift:1:r4", amount: 5, changes: map[int64]int64{1: -5, 2: 4},
+		}, wantErr: errs.Internal(nil)},
+	}
+
+	l := &ledger{wallets: make(map[int64]int64)}
+	s := &giftService{walletRepo: &fakeWalletRepo{l: l}}
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			before := l.clone()
+			called := false
+			txID, balance, err := s.post(context.Background(), step.owner, step.posting, func(repository.WalletRepository, int64) error {
+				called = true
+				return nil
+			})
+			if step.wantErr != nil {
+				if !errors.Is(err, step.wantErr) {
+					t.Fatalf("err = %v, want %v", err, step.wantErr)
+				}
+				// 失败的记账不留下任何变动
+				if !maps.Equal(l.wallets, before.wallets) || len(l.txs) != len(before.txs) || len(l.entries) != len(before.entries) {
+					t.Error("failed posting changed the ledger")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if step.wantTx != 0 && txID != step.wantTx {
+					t.Errorf("txID = %d, want %d", txID, step.wantTx)
+				}
+				if balance != step.wantBalance {
+					t.Errorf("balance = %d, want %d", balance, step.wantBalance)
+				}
+			}
+			if called != step.wantAfter {
+				t.Errorf("after called = %v, want %v", called, step.wantAfter)
+			}
+			checkLedger(t, l)
+		})
+	}
+}
+
+func TestPostingMatches(t *testing.T) {
+	p := transfer("gift:1:r1", 1, 2, 30)
+	tx := &model.LedgerTransaction{ID: 7, Type: model.TxTypeGift, BizNo: p.bizNo, Amount: 30}
+	entry := func(account, amount int64) *model.LedgerEntry {
+		return &model.LedgerEntry{TransactionID: 7, AccountID: account, Amount: amount}
+	}
+	tests := []struct {
+		name    string
+		tx      *model.LedgerTransaction
+		entries []*model.LedgerEntry
+		want    bool
+	}{
+		{"same", tx, []*model.LedgerEntry{entry(1, -30), entry(2, 30)}, true},
+		{"entry order does not matter", tx, []*model.LedgerEntry{entry(2, 30), entry(1, -30)}, true},
+		{"different type", &model.LedgerTransaction{Type: model.TxTypeRecharge, Amount: 30}, []*model.LedgerEntry{entry(1, -30), entry(2, 30)}, false},
+		{"different amount", &model.LedgerTransaction{Type: model.TxTypeGift, Amount: 40}, []*model.LedgerEntry{entry(1, -30), entry(2, 30)}, false},
+		{"different account", tx, []*model.LedgerEntry{entry(1, -30), entry(3, 30)}, false},
+		{"different change", tx, []*model.LedgerEntry{entry(1, -20), entry(2, 20)}, false},
+		{"missing entry", tx, []*model.LedgerEntry{entry(1, -30)}, false},
+		{"extra entry", tx, []*model.LedgerEntry{entry(1, -30), entry(2, 20), entry(3, 10)}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.matches(tt.tx, tt.entries); got != tt.want {
+				t.Errorf("matches = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
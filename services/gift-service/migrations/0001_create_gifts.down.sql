@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS gift_records;
+DROP TABLE IF EXISTS gifts;
@@ -0,0 +1,31 @@
+CREATE TABLE gifts (
+    id bigint NOT NULL AUTO_INCREMENT,
+    name varchar(50) NOT NULL,
+    price bigint NOT NULL COMMENT '单价（金币）',
+    animation varchar(255) DEFAULT NULL,
+    sort bigint DEFAULT 0 COMMENT '排序，越小越靠前',
+    status tinyint DEFAULT 1 COMMENT '0-下架 1-上架',
+    created_at datetime(3) DEFAULT NULL,
+    updated_at datetime(3) DEFAULT NULL,
+    PRIMARY KEY (id),
+    KEY idx_gifts_status (status)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE gift_records (
+    id bigint NOT NULL AUTO_INCREMENT,
+    transaction_id bigint NOT NULL,
+    sender_id bigint NOT NULL,
+    receiver_id bigint NOT NULL,
+    room_id bigint NOT NULL,
+    gift_id bigint NOT NULL,
+    gift_name varchar(50) NOT NULL,
+    count bigint NOT NULL,
+    unit_price bigint NOT NULL,
+    total_price bigint NOT NULL,
+    created_at datetime(3) DEFAULT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY idx_gift_records_transaction_id (transaction_id),
+    KEY idx_gift_records_sender_id (sender_id),
+    KEY idx_gift_records_receiver_id (receiver_id),
+    KEY idx_gift_records_room_id (room_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,31 @@
+-- 钱包余额是 ledger_entries 的汇总缓存
+CREATE TABLE wallets (
+    user_id bigint NOT NULL,
+    balance bigint NOT NULL DEFAULT 0,
+    created_at datetime(3) DEFAULT NULL,
+    updated_at datetime(3) DEFAULT NULL,
+    PRIMARY KEY (user_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE ledger_transactions (
+    id bigint NOT NULL AUTO_INCREMENT,
+    type varchar(20) NOT NULL,
+    biz_no varchar(100) NOT NULL COMMENT '业务幂等号',
+    amount bigint NOT NULL,
+    created_at datetime(3) DEFAULT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY idx_ledger_transactions_biz_no (biz_no)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE ledger_entries (
+    id bigint NOT NULL AUTO_INCREMENT,
+    transaction_id bigint NOT NULL,
+    account_id bigint NOT NULL,
+    amount bigint NOT NULL COMMENT '正数入账，负数出账',
+    balance_after bigint NOT NULL,
+    type varchar(20) NOT NULL,
+    created_at datetime(3) DEFAULT NULL,
+    PRIMARY KEY (id),
+    KEY idx_ledger_entries_transaction_id (transaction_id),
+    KEY idx_ledger_entries_account (account_id, created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,9 @@
+// Package migrations 礼物服务的数据库迁移脚本，由服务启动时或 migrate 命令执行
+package migrations
+
+import "embed"
+
+// FS 形如 0001_create_users.up.sql / 0001_create_users.down.sql 的迁移文件
+//
+//go:embed *.sql
+var FS embed.FS
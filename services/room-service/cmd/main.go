@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc/reflection"
+	roomPb "live-stream-platform/gen/proto/room"
+	userPb "live-stream-platform/gen/proto/user"
+	"live-stream-platform/pkg/config"
+	"live-stream-platform/pkg/database"
+	"live-stream-platform/pkg/grpcx"
+	"live-stream-platform/pkg/healthcheck"
+	"live-stream-platform/pkg/jwt"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/pkg/metrics"
+	"live-stream-platform/pkg/tracing"
+	"live-stream-platform/services/room-service/internal/handler"
+	"live-stream-platform/services/room-service/internal/repository"
+	"live-stream-platform/services/room-service/internal/service"
+	"live-stream-platform/services/room-service/migrations"
+)
+
+// liveRoomsInterval 刷新直播中房间数指标的间隔
+const liveRoomsInterval = 30 * time.Second
+
+func main() {
+	// 1. 加载配置
+	cfg, err := config.Load()
+	if err != nil {
+		logger.Fatal("Failed to load config", logger.Err(err))
+	}
+	logger.Init("room-service", &cfg.Log)
+	// 热更新日志级别
+	go config.Watch(context.Background(), config.DefaultWatchInterval, func(c *config.Config) {
+		logger.SetLevel(c.Log.Level)
+	})
+	slog.Info("Starting Room Service...")
+
+	shutdownTracing, err := tracing.Init("room-service", &cfg.Tracing)
+	if err != nil {
+		logger.Fatal("Failed to init tracing", logger.Err(err))
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			slog.Error("Failed to flush traces", logger.Err(err))
+		}
+	}()
+
+	// 2. 初始化数据库
+	if err := database.Init(&cfg.Database); err != nil {
+		logger.Fatal("Failed to init database", logger.Err(err))
+	}
+	defer database.Close()
+	slog.Info("Database initialized")
+	if *cfg.Database.AutoMigrate {
+		if err := database.Migrate(context.Background(), "room-service", migrations.FS); err != nil {
+			logger.Fatal("Failed to migrate database", logger.Err(err))
+		}
+	}
+	jwt.Init(cfg.JWT.Secret)
+
+	// 3. 连接用户服务（校验用户会话）
+	userConn, err := grpcx.NewClient(cfg.Services.UserService,
+		grpcx.AsService("room-service"),
+	)
+	if err != nil {
+		logger.Fatal("Failed to dial user service", logger.Err(err))
+	}
+	defer userConn.Close()
+
+	// 4. 创建依赖实例
+	roomRepo := repository.NewRoomRepository(database.DB)
+	roomService := service.NewRoomService(roomRepo)
+	health := healthcheck.New(healthcheck.DefaultInterval, roomPb.RoomService_ServiceDesc.ServiceName)
+	health.Add("mysql", database.Ping)
+	healthCtx, stopHealth := context.WithCancel(context.Background())
+	defer stopHealth()
+	go health.Run(healthCtx)
+	go reportLiveRooms(healthCtx, roomService)
+	roomHandler := handler.NewRoomHandler(roomService, health)
+	slog.Info("Room service initialized")
+
+	// 5. 创建 gRPC 服务器
+	list, err := net.Listen("tcp", ":"+cfg.Server.Port)
+	if err != nil {
+		logger.Fatal("Failed to listen", logger.Err(err))
+	}
+	grpcServer := grpcx.NewServer(
+		grpcx.WithAuth(grpcx.VerifyToken(userPb.NewUserServiceClient(userConn), grpcx.DefaultVerifyCacheTTL),
+			roomPb.RoomService_GetRoom_FullMethodName,
+			roomPb.RoomService_ListLiveRooms_FullMethodName,
+			// 推流回调由推流密钥鉴权
+			roomPb.RoomService_AuthorizePublish_FullMethodName,
+			roomPb.RoomService_PublishDone_FullMethodName,
+			roomPb.RoomService_Health_FullMethodName,
+		),
+	)
+	// 6. 注册服务
+	roomPb.RegisterRoomServiceServer(grpcServer, roomHandler)
+	health.Register(grpcServer)
+	reflection.Register(grpcServer)
+
+	// 7. 启动服务
+	metricsServer, err := metrics.Serve(cfg.MetricsPort("room-service"))
+	if err != nil {
+		logger.Fatal("Failed to serve metrics", logger.Err(err))
+	}
+	defer metricsServer.Close()
+	go func() {
+		slog.Info("Room service listening", slog.String("port", cfg.Server.Port))
+		if err := grpcServer.Serve(list); err != nil {
+			logger.Fatal("Failed to serve", logger.Err(err))
+		}
+	}()
+
+	// 8. 优雅关停
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+	slog.Info("Shutting down Room Service...")
+	health.Drain(healthcheck.DefaultDrainDelay)
+	stopHealth()
+	grpcServer.GracefulStop()
+	slog.Info("Room Service stopped")
+}
+
+// reportLiveRooms 周期性刷新直播中的房间数指标
+func reportLiveRooms(ctx context.Context, roomService service.RoomService) {
+	ticker := time.NewTicker(liveRoomsInterval)
+	defer ticker.Stop()
+	for {
+		total, err := roomService.CountLiveRooms(ctx)
+		if err != nil {
+			slog.Error("Failed to count live rooms", logger.Err(err))
+		} else {
+			metrics.LiveRooms.Set(float64(total))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
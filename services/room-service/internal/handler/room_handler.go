@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"context"
+
+	commonPb "live-stream-platform/gen/proto/common"
+	roomPb "live-stream-platform/gen/proto/room"
+	"live-stream-platform/pkg/errs"
+	"live-stream-platform/pkg/grpcx"
+	"live-stream-platform/pkg/healthcheck"
+	"live-stream-platform/services/room-service/internal/service"
+)
+
+type RoomHandler struct {
+	roomPb.UnimplementedRoomServiceServer
+	roomService service.RoomService
+	health      *healthcheck.Checker
+}
+
+func NewRoomHandler(roomService service.RoomService, health *healthcheck.Checker) *RoomHandler {
+	return &RoomHandler{
+		roomService: roomService,
+		health:      health,
+	}
+}
+
+// CreateRoom 创建直播间
+func (h *RoomHandler) CreateRoom(ctx context.Context, req *roomPb.CreateRoomRequest) (*roomPb.CreateRoomResponse, error) {
+	if err := grpcx.RequireUser(ctx, req.OwnerId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	roomID, err := h.roomService.CreateRoom(ctx, req)
+	if err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &roomPb.CreateRoomResponse{
+		Code:    0,
+		Message: "success",
+		RoomId:  roomID,
+	}, nil
+}
+
+// GetRoom 获取直播间信息
+func (h *RoomHandler) GetRoom(ctx context.Context, req *roomPb.GetRoomRequest) (*roomPb.GetRoomResponse, error) {
+	room, err := h.roomService.GetRoom(ctx, req.RoomId)
+	if err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &roomPb.GetRoomResponse{
+		Code:    0,
+		Message: "success",
+		Room:    room,
+	}, nil
+}
+
+// UpdateRoom 更新直播间信息
+func (h *RoomHandler) UpdateRoom(ctx context.Context, req *roomPb.UpdateRoomRequest) (*commonPb.Response, error) {
+	if err := grpcx.RequireUser(ctx, req.UserId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	if err := h.roomService.UpdateRoom(ctx, req); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &commonPb.Response{
+		Code:    0,
+		Message: "success",
+	}, nil
+}
+
+// StartLive 开始直播
+func (h *RoomHandler) StartLive(ctx context.Context, req *roomPb.StartLiveRequest) (*commonPb.Response, error) {
+	if err := grpcx.RequireUser(ctx, req.UserId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	if err := h.roomService.StartLive(ctx, req.RoomId, req.UserId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &commonPb.Response{
+		Code:    0,
+		Message: "success",
+	}, nil
+}
+
+// StopLive 结束直播
+func (h *RoomHandler) StopLive(ctx context.Context, req *roomPb.StopLiveRequest) (*commonPb.Response, error) {
+	if err := grpcx.RequireUser(ctx, req.UserId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	if err := h.roomService.StopLive(ctx, req.RoomId, req.UserId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &commonPb.Response{
+		Code:    0,
+		Message: "success",
+	}, nil
+}
+
+// ListLiveRooms 分页获取直播中的房间
+func (h *RoomHandler) ListLiveRooms(ctx context.Context, req *roomPb.ListLiveRoomsRequest) (*roomPb.ListLiveRoomsResponse, error) {
+	rooms, page, err := h.roomService.ListLiveRooms(ctx, req.Page, req.Category)
+	if err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &roomPb.ListLiveRoomsResponse{
+		Code:    0,
+		Message: "success",
+		Rooms:   rooms,
+		Page:    page,
+	}, nil
+}
+
+// GenerateStreamKey 生成推流密钥
+func (h *RoomHandler) GenerateStreamKey(ctx context.Context, req *roomPb.GenerateStreamKeyRequest) (*roomPb.GenerateStreamKeyResponse, error) {
+	if err := grpcx.RequireUser(ctx, req.UserId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	streamName, streamKey, err := h.roomService.GenerateStreamKey(ctx, req.RoomId, req.UserId)
+	if err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &roomPb.GenerateStreamKeyResponse{
+		Code:       0,
+		Message:    "success",
+		StreamName: streamName,
+		StreamKey:  streamKey,
+	}, nil
+}
+
+// AuthorizePublish 推流鉴权
+func (h *RoomHandler) AuthorizePublish(ctx context.Context, req *roomPb.AuthorizePublishRequest) (*commonPb.Response, error) {
+	if err := h.roomService.AuthorizePublish(ctx, req.StreamName, req.StreamKey); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &commonPb.Response{
+		Code:    0,
+		Message: "success",
+	}, nil
+}
+
+// PublishDone 推流结束
+func (h *RoomHandler) PublishDone(ctx context.Context, req *roomPb.PublishDoneRequest) (*commonPb.Response, error) {
+	if err := h.roomService.PublishDone(ctx, req.StreamName, req.StreamKey); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &commonPb.Response{
+		Code:    0,
+		Message: "success",
+	}, nil
+}
+
+// ForceStopLive 强制结束直播
+func (h *RoomHandler) ForceStopLive(ctx context.Context, req *roomPb.ForceStopLiveRequest) (*commonPb.Response, error) {
+	if err := grpcx.RequireService(ctx); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	if err := h.roomService.ForceStopLive(ctx, req.RoomId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &commonPb.Response{
+		Code:    0,
+		Message: "success",
+	}, nil
+}
+
+// Health 健康检查，返回各依赖的最近检查结果
+func (h *RoomHandler) Health(ctx context.Context, req *roomPb.HealthRequest) (*roomPb.HealthResponse, error) {
+	return &roomPb.HealthResponse{
+		Status:       h.health.Status(),
+		Dependencies: h.health.Dependencies(),
+	}, nil
+}
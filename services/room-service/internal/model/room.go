@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// 直播间状态
+const (
+	RoomStatusIdle  = 0 // 未开播
+	RoomStatusLive  = 1 // 直播中
+	RoomStatusEnded = 2 // 已结束
+)
+
+type Room struct {
+	ID                 int64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	OwnerID            int64      `gorm:"uniqueIndex;not null" json:"owner_id"`
+	Title              string     `gorm:"type:varchar(100);not null" json:"title"`
+	Cover              string     `gorm:"type:varchar(255)" json:"cover"`
+	Category           string     `gorm:"type:varchar(50);index:idx_rooms_status_category,priority:2" json:"category"`
+	Status             int        `gorm:"type:tinyint;default:0;index:idx_rooms_status_category,priority:1" json:"status"` // 0-未开播 1-直播中 2-已结束
+	StartedAt          *time.Time `json:"started_at"`
+	EndedAt            *time.Time `json:"ended_at"`
+	StreamKeyHash      string     `gorm:"type:varchar(255)" json:"-"` // 推流密钥的 SHA-256 哈希，明文只在生成时返回一次
+	StreamKeyRotatedAt *time.Time `json:"-"`
+	CreatedAt          time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt          time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (Room) TableName() string {
+	return "rooms"
+}
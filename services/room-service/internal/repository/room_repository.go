@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"live-stream-platform/services/room-service/internal/model"
+)
+
+type RoomRepository interface {
+	Create(ctx context.Context, room *model.Room) error
+	GetByID(ctx context.Context, id int64) (*model.Room, error)
+	GetByOwnerID(ctx context.Context, ownerID int64) (*model.Room, error)
+	// Update 只更新标题、封面与分类，状态与推流密钥由专门的方法维护
+	Update(ctx context.Context, room *model.Room) error
+	// UpdateStatus 仅当当前状态属于 fromStatus 时更新，返回是否更新成功
+	UpdateStatus(ctx context.Context, id int64, fromStatus []int, fields map[string]interface{}) (bool, error)
+	ListByStatus(ctx context.Context, status int, category string, offset, limit int) ([]*model.Room, int64, error)
+	CountByStatus(ctx context.Context, status int) (int64, error)
+	UpdateStreamKeyHash(ctx context.Context, id int64, hash string) error
+}
+
+type roomRepository struct {
+	db *gorm.DB
+}
+
+func NewRoomRepository(db *gorm.DB) RoomRepository {
+	return &roomRepository{
+		db: db,
+	}
+}
+
+func (rr *roomRepository) Create(ctx context.Context, room *model.Room) error {
+	return rr.db.WithContext(ctx).Create(room).Error
+}
+
+func (rr *roomRepository) GetByID(ctx context.Context, id int64) (*model.Room, error) {
+	var room model.Room
+	if err := rr.db.WithContext(ctx).Where("id = ?", id).First(&room).Error; err != nil {
+		return nil, err
+	}
+	return &room, nil
+}
+
+func (rr *roomRepository) GetByOwnerID(ctx context.Context, ownerID int64) (*model.Room, error) {
+	var room model.Room
+	if err := rr.db.WithContext(ctx).Where("owner_id = ?", ownerID).First(&room).Error; err != nil {
+		return nil, err
+	}
+	return &room, nil
+}
+
+func (rr *roomRepository) Update(ctx context.Context, room *model.Room) error {
+	return rr.db.WithContext(ctx).Model(&model.Room{}).
+		Where("id = ?", room.ID).
+		Select("title", "cover", "category", "updated_at").
+		Updates(room).Error
+}
+
+func (rr *roomRepository) UpdateStatus(ctx context.Context, id int64, fromStatus []int, fields map[string]interface{}) (bool, error) {
+	result := rr.db.WithContext(ctx).Model(&model.Room{}).
+		Where("id = ? AND status IN ?", id, fromStatus).
+		Updates(fields)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (rr *roomRepository) ListByStatus(ctx context.Context, status int, category string, offset, limit int) ([]*model.Room, int64, error) {
+	query := rr.db.WithContext(ctx).Model(&model.Room{}).Where("status = ?", status)
+	if category != "" {
+		query = query.Where("category = ?", category)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var rooms []*model.Room
+	if err := query.Order("started_at DESC, id DESC").Offset(offset).Limit(limit).Find(&rooms).Error; err != nil {
+		return nil, 0, err
+	}
+	return rooms, total, nil
+}
+
+func (rr *roomRepository) CountByStatus(ctx context.Context, status int) (int64, error) {
+	var total int64
+	err := rr.db.WithContext(ctx).Model(&model.Room{}).Where("status = ?", status).Count(&total).Error
+	return total, err
+}
+
+func (rr *roomRepository) UpdateStreamKeyHash(ctx context.Context, id int64, hash string) error {
+	return rr.db.WithContext(ctx).Model(&model.Room{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"stream_key_hash":       hash,
+		"stream_key_rotated_at": time.Now(),
+	}).Error
+}
@@ -0,0 +1,20 @@
+package service
+
+import "live-stream-platform/pkg/errs"
+
+// 直播间服务错误码（20xxx），对外发布后数值不可再修改
+var (
+	ErrInvalidParams     = errs.InvalidArgument(20001, "INVALID_PARAMS", "invalid parameters")
+	ErrRoomNotFound      = errs.NotFound(20002, "ROOM_NOT_FOUND", "room not found")
+	ErrRoomExists        = errs.AlreadyExists(20003, "ROOM_EXISTS", "room already exists")
+	ErrNotRoomOwner      = errs.PermissionDenied(20004, "NOT_ROOM_OWNER", "permission denied: not the room owner")
+	ErrRoomAlreadyLive   = errs.InvalidArgument(20005, "ROOM_ALREADY_LIVE", "room is already live")
+	ErrRoomNotLive       = errs.InvalidArgument(20006, "ROOM_NOT_LIVE", "room is not live")
+	ErrInvalidStreamName = errs.InvalidArgument(20007, "INVALID_STREAM_NAME", "invalid stream name")
+	ErrInvalidStreamKey  = errs.PermissionDenied(20008, "INVALID_STREAM_KEY", "invalid stream key")
+)
+
+// invalidParam 单个字段校验失败
+func invalidParam(field, description string) error {
+	return ErrInvalidParams.WithMessage(description).WithField(field, description)
+}
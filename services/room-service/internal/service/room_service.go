@@ -0,0 +1,353 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+	commonPb "live-stream-platform/gen/proto/common"
+	roomPb "live-stream-platform/gen/proto/room"
+	"live-stream-platform/pkg/database"
+	"live-stream-platform/pkg/errs"
+	"live-stream-platform/pkg/utils"
+	"live-stream-platform/services/room-service/internal/model"
+	"live-stream-platform/services/room-service/internal/repository"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	maxTitleLength  = 100
+	streamKeyLength = 32
+)
+
+// RoomService 直播间服务接口
+type RoomService interface {
+	// CreateRoom 创建直播间
+	CreateRoom(ctx context.Context, req *roomPb.CreateRoomRequest) (int64, error)
+	// GetRoom 获取直播间信息
+	GetRoom(ctx context.Context, roomID int64) (*roomPb.RoomInfo, error)
+	// UpdateRoom 更新直播间信息
+	UpdateRoom(ctx context.Context, req *roomPb.UpdateRoomRequest) error
+	// StartLive 开始直播
+	StartLive(ctx context.Context, roomID, userID int64) error
+	// StopLive 结束直播
+	StopLive(ctx context.Context, roomID, userID int64) error
+	// ListLiveRooms 分页获取直播中的房间
+	ListLiveRooms(ctx context.Context, page *commonPb.PageRequest, category string) ([]*roomPb.RoomInfo, *commonPb.PageResponse, error)
+	// CountLiveRooms 统计直播中的房间数
+	CountLiveRooms(ctx context.Context) (int64, error)
+	// GenerateStreamKey 生成或轮换推流密钥，返回推流名与密钥明文
+	GenerateStreamKey(ctx context.Context, roomID, userID int64) (string, string, error)
+	// AuthorizePublish 校验推流密钥并开播
+	AuthorizePublish(ctx context.Context, streamName, streamKey string) error
+	// PublishDone 校验推流密钥并下播
+	PublishDone(ctx context.Context, streamName, streamKey string) error
+	// ForceStopLive 管理员强制下播，同时作废推流密钥
+	ForceStopLive(ctx context.Context, roomID int64) error
+}
+
+// roomService 直播间服务实现
+type roomService struct {
+	roomRepo repository.RoomRepository
+}
+
+func NewRoomService(roomRepo repository.RoomRepository) RoomService {
+	return &roomService{
+		roomRepo: roomRepo,
+	}
+}
+
+// CreateRoom 创建直播间，每个用户只能拥有一个直播间
+func (s *roomService) CreateRoom(ctx context.Context, req *roomPb.CreateRoomRequest) (int64, error) {
+	if req.OwnerId <= 0 {
+		return 0, invalidParam("owner_id", "invalid owner id")
+	}
+	if !validateTitle(req.Title) {
+		return 0, invalidParam("title", fmt.Sprintf("invalid title: 1-%d characters", maxTitleLength))
+	}
+	// 读主库：从库延迟时可能查不到刚创建的房间
+	if _, err := s.roomRepo.GetByOwnerID(database.WithPrimary(ctx), req.OwnerId); err == nil {
+		return 0, ErrRoomExists
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, errs.Wrap(err, "failed to check room")
+	}
+
+	room := &model.Room{
+		OwnerID:  req.OwnerId,
+		Title:    req.Title,
+		Cover:    req.Cover,
+		Category: req.Category,
+		Status:   model.RoomStatusIdle,
+	}
+	if err := s.roomRepo.Create(ctx, room); err != nil {
+		return 0, errs.Wrap(err, "failed to create room")
+	}
+	return room.ID, nil
+}
+
+// GetRoom 获取直播间信息
+func (s *roomService) GetRoom(ctx context.Context, roomID int64) (*roomPb.RoomInfo, error) {
+	room, err := s.getRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	return toRoomInfo(room), nil
+}
+
+// UpdateRoom 更新直播间信息
+func (s *roomService) UpdateRoom(ctx context.Context, req *roomPb.UpdateRoomRequest) error {
+	room, err := s.getOwnedRoom(ctx, req.RoomId, req.UserId)
+	if err != nil {
+		return err
+	}
+	if req.Title != "" {
+		if !validateTitle(req.Title) {
+			return invalidParam("title", fmt.Sprintf("invalid title: 1-%d characters", maxTitleLength))
+		}
+		room.Title = req.Title
+	}
+	if req.Cover != "" {
+		room.Cover = req.Cover
+	}
+	if req.Category != "" {
+		room.Category = req.Category
+	}
+	if err := s.roomRepo.Update(ctx, room); err != nil {
+		return errs.Wrap(err, "failed to update room")
+	}
+	return nil
+}
+
+// StartLive 开始直播：未开播或已结束 -> 直播中
+func (s *roomService) StartLive(ctx context.Context, roomID, userID int64) error {
+	if _, err := s.getOwnedRoom(ctx, roomID, userID); err != nil {
+		return err
+	}
+	return s.startLive(ctx, roomID)
+}
+
+// StopLive 结束直播：直播中 -> 已结束
+func (s *roomService) StopLive(ctx context.Context, roomID, userID int64) error {
+	if _, err := s.getOwnedRoom(ctx, roomID, userID); err != nil {
+		return err
+	}
+	return s.stopLive(ctx, roomID)
+}
+
+// ListLiveRooms 分页获取直播中的房间
+func (s *roomService) ListLiveRooms(ctx context.Context, page *commonPb.PageRequest, category string) ([]*roomPb.RoomInfo, *commonPb.PageResponse, error) {
+	pageNum, pageSize := normalizePage(page)
+	rooms, total, err := s.roomRepo.ListByStatus(ctx, model.RoomStatusLive, category, int((pageNum-1)*pageSize), int(pageSize))
+	if err != nil {
+		return nil, nil, errs.Wrap(err, "failed to list rooms")
+	}
+	roomInfos := make([]*roomPb.RoomInfo, 0, len(rooms))
+	for _, room := range rooms {
+		roomInfos = append(roomInfos, toRoomInfo(room))
+	}
+	return roomInfos, &commonPb.PageResponse{
+		Page:     pageNum,
+		PageSize: pageSize,
+		Total:    total,
+	}, nil
+}
+
+// CountLiveRooms 统计直播中的房间数
+func (s *roomService) CountLiveRooms(ctx context.Context) (int64, error) {
+	total, err := s.roomRepo.CountByStatus(ctx, model.RoomStatusLive)
+	if err != nil {
+		return 0, errs.Wrap(err, "failed to count live rooms")
+	}
+	return total, nil
+}
+
+// GenerateStreamKey 生成或轮换推流密钥，只保存 SHA-256 哈希
+func (s *roomService) GenerateStreamKey(ctx context.Context, roomID, userID int64) (string, string, error) {
+	room, err := s.getOwnedRoom(ctx, roomID, userID)
+	if err != nil {
+		return "", "", err
+	}
+	streamKey, err := utils.GenerateRandomString(streamKeyLength)
+	if err != nil {
+		return "", "", errs.Wrap(err, "failed to generate stream key")
+	}
+	if err := s.roomRepo.UpdateStreamKeyHash(ctx, room.ID, hashStreamKey(streamKey)); err != nil {
+		return "", "", errs.Wrap(err, "failed to save stream key")
+	}
+	return streamName(room.ID), streamKey, nil
+}
+
+// AuthorizePublish 校验推流密钥并开播
+func (s *roomService) AuthorizePublish(ctx context.Context, name, streamKey string) error {
+	room, err := s.verifyStreamKey(ctx, name, streamKey)
+	if err != nil {
+		return err
+	}
+	if room.Status == model.RoomStatusLive {
+		// 断线重连时房间仍处于直播中，直接放行
+		return nil
+	}
+	return s.startLive(ctx, room.ID)
+}
+
+// PublishDone 校验推流密钥并下播
+func (s *roomService) PublishDone(ctx context.Context, name, streamKey string) error {
+	room, err := s.verifyStreamKey(ctx, name, streamKey)
+	if err != nil {
+		return err
+	}
+	if room.Status != model.RoomStatusLive {
+		return nil
+	}
+	return s.stopLive(ctx, room.ID)
+}
+
+// ForceStopLive 管理员强制下播，作废推流密钥以防止主播立即重新推流。
+// 房间未在直播时同样返回成功，调用方可以安全重试
+func (s *roomService) ForceStopLive(ctx context.Context, roomID int64) error {
+	room, err := s.getRoom(database.WithPrimary(ctx), roomID)
+	if err != nil {
+		return err
+	}
+	if err := s.roomRepo.UpdateStreamKeyHash(ctx, room.ID, ""); err != nil {
+		return errs.Wrap(err, "failed to revoke stream key")
+	}
+	if err := s.stopLive(ctx, room.ID); err != nil && !errors.Is(err, ErrRoomNotLive) {
+		return err
+	}
+	return nil
+}
+
+// verifyStreamKey 根据推流名找到直播间并校验密钥
+func (s *roomService) verifyStreamKey(ctx context.Context, name, streamKey string) (*model.Room, error) {
+	roomID, err := strconv.ParseInt(name, 10, 64)
+	if err != nil || roomID <= 0 {
+		return nil, ErrInvalidStreamName
+	}
+	// 读主库：从库延迟时可能读到已轮换或已作废的密钥
+	room, err := s.getRoom(database.WithPrimary(ctx), roomID)
+	if err != nil {
+		return nil, err
+	}
+	if room.StreamKeyHash == "" || streamKey == "" ||
+		subtle.ConstantTimeCompare([]byte(hashStreamKey(streamKey)), []byte(room.StreamKeyHash)) != 1 {
+		return nil, ErrInvalidStreamKey
+	}
+	return room, nil
+}
+
+func (s *roomService) startLive(ctx context.Context, roomID int64) error {
+	ok, err := s.roomRepo.UpdateStatus(ctx, roomID,
+		[]int{model.RoomStatusIdle, model.RoomStatusEnded},
+		map[string]interface{}{
+			"status":     model.RoomStatusLive,
+			"started_at": time.Now(),
+			"ended_at":   nil,
+		})
+	if err != nil {
+		return errs.Wrap(err, "failed to start live")
+	}
+	if !ok {
+		return ErrRoomAlreadyLive
+	}
+	return nil
+}
+
+func (s *roomService) stopLive(ctx context.Context, roomID int64) error {
+	ok, err := s.roomRepo.UpdateStatus(ctx, roomID,
+		[]int{model.RoomStatusLive},
+		map[string]interface{}{
+			"status":   model.RoomStatusEnded,
+			"ended_at": time.Now(),
+		})
+	if err != nil {
+		return errs.Wrap(err, "failed to stop live")
+	}
+	if !ok {
+		return ErrRoomNotLive
+	}
+	return nil
+}
+
+func (s *roomService) getRoom(ctx context.Context, roomID int64) (*model.Room, error) {
+	room, err := s.roomRepo.GetByID(ctx, roomID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRoomNotFound
+		}
+		return nil, errs.Wrap(err, "failed to get room")
+	}
+	return room, nil
+}
+
+// getOwnedRoom 获取直播间并校验操作者是否为房主。只用于写操作，读主库
+func (s *roomService) getOwnedRoom(ctx context.Context, roomID, userID int64) (*model.Room, error) {
+	room, err := s.getRoom(database.WithPrimary(ctx), roomID)
+	if err != nil {
+		return nil, err
+	}
+	if room.OwnerID != userID {
+		return nil, ErrNotRoomOwner
+	}
+	return room, nil
+}
+
+// streamName 推流名即直播间 ID，密钥通过 ?key= 参数传递，避免出现在播放地址中
+func streamName(roomID int64) string {
+	return strconv.FormatInt(roomID, 10)
+}
+
+// hashStreamKey 推流密钥是高熵随机串，SHA-256 足以防止泄露的哈希被还原，
+// 且公开的推流回调上每次校验都很廉价，不像 bcrypt 那样可以被用来消耗 CPU
+func hashStreamKey(streamKey string) string {
+	sum := sha256.Sum256([]byte(streamKey))
+	return hex.EncodeToString(sum[:])
+}
+
+func validateTitle(title string) bool {
+	n := utf8.RuneCountInString(title)
+	return n > 0 && n <= maxTitleLength
+}
+
+func normalizePage(page *commonPb.PageRequest) (int32, int32) {
+	pageNum, pageSize := int32(1), int32(defaultPageSize)
+	if page != nil {
+		if page.Page > 0 {
+			pageNum = page.Page
+		}
+		if page.PageSize > 0 {
+			pageSize = page.PageSize
+		}
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	return pageNum, pageSize
+}
+
+func toRoomInfo(room *model.Room) *roomPb.RoomInfo {
+	info := &roomPb.RoomInfo{
+		Id:        room.ID,
+		OwnerId:   room.OwnerID,
+		Title:     room.Title,
+		Cover:     room.Cover,
+		Category:  room.Category,
+		Status:    int32(room.Status),
+		CreatedAt: room.CreatedAt.Unix(),
+	}
+	if room.StartedAt != nil {
+		info.StartedAt = room.StartedAt.Unix()
+	}
+	if room.EndedAt != nil {
+		info.EndedAt = room.EndedAt.Unix()
+	}
+	return info
+}
@@ -0,0 +1,17 @@
+CREATE TABLE rooms (
+    id bigint NOT NULL AUTO_INCREMENT,
+    owner_id bigint NOT NULL,
+    title varchar(100) NOT NULL,
+    cover varchar(255) DEFAULT NULL,
+    category varchar(50) DEFAULT NULL,
+    status tinyint DEFAULT 0 COMMENT '0-未开播 1-直播中 2-已结束',
+    started_at datetime(3) DEFAULT NULL,
+    ended_at datetime(3) DEFAULT NULL,
+    stream_key_hash varchar(255) DEFAULT NULL,
+    stream_key_rotated_at datetime(3) DEFAULT NULL,
+    created_at datetime(3) DEFAULT NULL,
+    updated_at datetime(3) DEFAULT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY idx_rooms_owner_id (owner_id),
+    KEY idx_rooms_status_category (status, category)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,2 @@
+-- bcrypt 哈希已清空，无法恢复
+SELECT 1;
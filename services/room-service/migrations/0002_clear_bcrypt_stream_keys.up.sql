@@ -0,0 +1,2 @@
+-- 推流密钥改为 SHA-256 哈希，旧的 bcrypt 哈希无法校验，清空后主播需重新生成密钥
+UPDATE rooms SET stream_key_hash = NULL WHERE stream_key_hash LIKE '$2%';
@@ -1,98 +1,207 @@
 package main
 
 import (
-	"google.golang.org/grpc"
+	"context"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
 	"google.golang.org/grpc/reflection"
+	"gorm.io/gorm"
+	commonPb "live-stream-platform/gen/proto/common"
 	userPb "live-stream-platform/gen/proto/user"
 	"live-stream-platform/pkg/config"
 	"live-stream-platform/pkg/database"
+	"live-stream-platform/pkg/events"
+	"live-stream-platform/pkg/grpcx"
+	"live-stream-platform/pkg/healthcheck"
 	"live-stream-platform/pkg/jwt"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/pkg/mailer"
+	"live-stream-platform/pkg/metrics"
+	"live-stream-platform/pkg/outbox"
+	"live-stream-platform/pkg/rabbitmq"
 	pkgRedis "live-stream-platform/pkg/redis"
+	"live-stream-platform/pkg/tracing"
 	"live-stream-platform/services/user-service/internal/handler"
 	"live-stream-platform/services/user-service/internal/repository"
 	"live-stream-platform/services/user-service/internal/service"
-	"log"
-	"net"
-	"os"
-	"os/signal"
-	"syscall"
-	"time"
+	"live-stream-platform/services/user-service/migrations"
 )
 
+// verificationMailQueue 发送注册验证邮件的队列，也是去重记录中的消费者名
+const verificationMailQueue = "user-service.verification_mail"
+
 func main() {
-	log.Println("Starting User Service...")
 	//1. 加载配置
-	cfg := config.Load()
+	cfg, err := config.Load()
+	if err != nil {
+		logger.Fatal("Failed to load config", logger.Err(err))
+	}
+	logger.Init("user-service", &cfg.Log)
+	// 热更新日志级别
+	go config.Watch(context.Background(), config.DefaultWatchInterval, func(c *config.Config) {
+		logger.SetLevel(c.Log.Level)
+	})
+	slog.Info("Starting User Service...")
 
-	// 2. 初始化数据库
-	dbConfig := &config.DatabaseConfig{
-		Host:         cfg.Database.Host,
-		Port:         cfg.Database.Port,
-		User:         cfg.Database.User,
-		Password:     cfg.Database.Password,
-		Database:     getEnv("DB_NAME", cfg.Database.Database),
-		MaxOpenConns: cfg.Database.MaxOpenConns,
-		MaxIdleConns: cfg.Database.MaxIdleConns,
-		MaxLifetime:  time.Hour,
+	shutdownTracing, err := tracing.Init("user-service", &cfg.Tracing)
+	if err != nil {
+		logger.Fatal("Failed to init tracing", logger.Err(err))
 	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			slog.Error("Failed to flush traces", logger.Err(err))
+		}
+	}()
 
-	if err := database.Init(dbConfig); err != nil {
-		log.Fatalf("Failed to init database: %v", err)
+	// 2. 初始化数据库
+	if err := database.Init(&cfg.Database); err != nil {
+		logger.Fatal("Failed to init database", logger.Err(err))
 	}
 	defer database.Close()
-	log.Println("Database initialized")
+	slog.Info("Database initialized")
+	if *cfg.Database.AutoMigrate {
+		if err := database.Migrate(context.Background(), "user-service", migrations.FS); err != nil {
+			logger.Fatal("Failed to migrate database", logger.Err(err))
+		}
+	}
 
 	if err := pkgRedis.Init(&cfg.Redis); err != nil {
-		log.Fatalf("Failed to init redis: %v", err)
+		logger.Fatal("Failed to init redis", logger.Err(err))
 	}
 	defer pkgRedis.Close()
-	log.Println("Redis initialized")
+	slog.Info("Redis initialized")
+
+	if err := rabbitmq.Init(&cfg.RabbitMQ); err != nil {
+		logger.Fatal("Failed to init rabbitmq", logger.Err(err))
+	}
+	defer rabbitmq.Close()
+	slog.Info("RabbitMQ initialized")
+
+	if err := events.Init("user-service", events.ContentTypeProtobuf); err != nil {
+		logger.Fatal("Failed to init events", logger.Err(err))
+	}
 	//4. 初始化 JWT
 	jwt.Init(cfg.JWT.Secret)
-	log.Println("JWT initialized")
+	slog.Info("JWT initialized")
+	mail, err := mailer.New(&cfg.Mail)
+	if err != nil {
+		logger.Fatal("Failed to init mailer", logger.Err(err))
+	}
 	// 5. 创建依赖实例
 	userRepo := repository.NewUserRepository(database.DB)
+	followRepo := repository.NewFollowRepository(database.DB)
 	//service 层
-	userService := service.NewUserService(userRepo, pkgRedis.GetClient(), cfg.JWT.ExpireHours)
+	userService := service.NewUserService(userRepo, followRepo, pkgRedis.GetClient(),
+		time.Duration(cfg.JWT.AccessExpireMinutes)*time.Minute,
+		time.Duration(cfg.JWT.RefreshExpireHours)*time.Hour,
+		service.EmailOptions{
+			Mailer:         mail,
+			Secret:         []byte(cfg.Mail.TokenSecret),
+			BaseURL:        cfg.Mail.BaseURL,
+			VerifyTokenTTL: cfg.Mail.VerifyTokenTTL,
+			ResetTokenTTL:  cfg.Mail.ResetTokenTTL,
+			ResendCooldown: cfg.Mail.ResendCooldown,
+		},
+	)
+	// 健康检查
+	health := healthcheck.New(healthcheck.DefaultInterval, userPb.UserService_ServiceDesc.ServiceName)
+	health.Add("mysql", database.Ping)
+	health.Add("redis", pkgRedis.Ping)
+	health.Add("rabbitmq", rabbitmq.Ping)
+	healthCtx, stopHealth := context.WithCancel(context.Background())
+	defer stopHealth()
+	go health.Run(healthCtx)
+	// 注册后发送验证邮件，按事件 ID 去重
+	verificationKeys := []string{events.TypeUserRegistered}
+	verificationConsumer, err := rabbitmq.NewConsumer(rabbitmq.ConsumerOptions{
+		Queue:       verificationMailQueue,
+		RoutingKeys: verificationKeys,
+	}, outbox.HandleEvent(database.DB, verificationMailQueue,
+		func(ctx context.Context, _ *gorm.DB, _ *commonPb.EventEnvelope, event *userPb.UserRegistered) error {
+			return userService.OnUserRegistered(ctx, event)
+		}))
+	if err != nil {
+		logger.Fatal("Failed to create verification mail consumer", logger.Err(err))
+	}
+	// 先声明队列再启动发件箱投递，否则启动前积压的 user.registered 会因没有绑定被退回
+	if err := verificationConsumer.Declare(); err != nil {
+		logger.Fatal("Failed to declare verification mail queue", logger.Err(err))
+	}
+	consumerCtx, stopConsumer := context.WithCancel(context.Background())
+	consumerDone := make(chan struct{})
+	go func() {
+		defer close(consumerDone)
+		verificationConsumer.Run(consumerCtx)
+	}()
+	// 发件箱投递
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	relayDone := make(chan struct{})
+	go func() {
+		defer close(relayDone)
+		outbox.NewRelay(database.DB, outbox.RelayOptions{
+			Subscribed: verificationKeys,
+		}).Run(relayCtx)
+	}()
 	//Handler 层
-	userHandler := handler.NewUserHandler(userService)
-	log.Println("User service initialized")
+	userHandler := handler.NewUserHandler(userService, health)
+	slog.Info("User service initialized")
 	// 6. 创建 gRPC 服务器
 	list, err := net.Listen("tcp", ":"+cfg.Server.Port)
 	if err != nil {
-		log.Fatalf("Failed to listen: %v", err)
+		logger.Fatal("Failed to listen", logger.Err(err))
 	}
-	grpcServer := grpc.NewServer(
-		grpc.MaxRecvMsgSize(4*1024*1024), //4MB
-		grpc.MaxSendMsgSize(4*1024*1024), //4MB
+	grpcServer := grpcx.NewServer(
+		grpcx.WithAuth(userService.VerifyToken,
+			userPb.UserService_Register_FullMethodName,
+			userPb.UserService_Login_FullMethodName,
+			userPb.UserService_RefreshToken_FullMethodName,
+			userPb.UserService_VerifyToken_FullMethodName,
+			userPb.UserService_GetUserInfo_FullMethodName,
+			userPb.UserService_GetUsersByIds_FullMethodName,
+			userPb.UserService_ListFollowers_FullMethodName,
+			userPb.UserService_ListFollowing_FullMethodName,
+			userPb.UserService_Health_FullMethodName,
+			userPb.UserService_VerifyEmail_FullMethodName,
+			userPb.UserService_ForgotPassword_FullMethodName,
+			userPb.UserService_ResetPassword_FullMethodName,
+		),
 	)
 	// 7. 注册服务
 	userPb.RegisterUserServiceServer(grpcServer, userHandler)
+	health.Register(grpcServer)
 	//8. 启动 gRPC 反射 （用于调试）
 	reflection.Register(grpcServer)
 	// 9. 启动服务
+	metricsServer, err := metrics.Serve(cfg.MetricsPort("user-service"))
+	if err != nil {
+		logger.Fatal("Failed to serve metrics", logger.Err(err))
+	}
+	defer metricsServer.Close()
 	go func() {
-		log.Printf("✓ User service listening on port %s", cfg.Server.Port)
-		log.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
-		log.Println("🚀 User Service Started Successfully!")
-		log.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+		slog.Info("User service listening", slog.String("port", cfg.Server.Port))
 		if err := grpcServer.Serve(list); err != nil {
-			log.Fatalf("Failed to serve: %v", err)
+			logger.Fatal("Failed to serve", logger.Err(err))
 		}
 	}()
 	// 10.优雅关停
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
-	log.Println("Shutting down User Service...")
+	slog.Info("Shutting down User Service...")
+	health.Drain(healthcheck.DefaultDrainDelay)
+	stopHealth()
 	grpcServer.GracefulStop()
-	log.Println("User Service stopped")
-}
-
-// getEnv 获取环境变量，如果不存在则返回默认值
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
-	}
-	return defaultValue
+	// 请求处理完后再停止投递，并等待进行中的批次完成；未发出的事件由其他实例或下次启动投递
+	stopRelay()
+	<-relayDone
+	stopConsumer()
+	<-consumerDone
+	slog.Info("User Service stopped")
 }
@@ -4,17 +4,22 @@ import (
 	"context"
 	commonPb "live-stream-platform/gen/proto/common"
 	userPb "live-stream-platform/gen/proto/user"
+	"live-stream-platform/pkg/errs"
+	"live-stream-platform/pkg/grpcx"
+	"live-stream-platform/pkg/healthcheck"
 	"live-stream-platform/services/user-service/internal/service"
 )
 
 type UserHandler struct {
 	userPb.UnimplementedUserServiceServer
 	userService service.UserService
+	health      *healthcheck.Checker
 }
 
-func NewUserHandler(userService service.UserService) *UserHandler {
+func NewUserHandler(userService service.UserService, health *healthcheck.Checker) *UserHandler {
 	return &UserHandler{
 		userService: userService,
+		health:      health,
 	}
 }
 
@@ -22,10 +27,7 @@ func NewUserHandler(userService service.UserService) *UserHandler {
 func (h *UserHandler) Register(ctx context.Context, req *userPb.RegisterRequest) (*userPb.RegisterResponse, error) {
 	userID, err := h.userService.Register(ctx, req)
 	if err != nil {
-		return &userPb.RegisterResponse{
-			Code:    1,
-			Message: err.Error(),
-		}, nil
+		return nil, errs.GRPCError(err)
 	}
 	return &userPb.RegisterResponse{
 		Code:    0,
@@ -36,29 +38,43 @@ func (h *UserHandler) Register(ctx context.Context, req *userPb.RegisterRequest)
 
 // Login 用户登录
 func (h *UserHandler) Login(ctx context.Context, req *userPb.LoginRequest) (*userPb.LoginResponse, error) {
-	token, user, err := h.userService.Login(ctx, req)
+	tokens, user, err := h.userService.Login(ctx, req)
 	if err != nil {
-		return &userPb.LoginResponse{
-			Code:    1,
-			Message: err.Error(),
-		}, nil
+		return nil, errs.GRPCError(err)
 	}
 	return &userPb.LoginResponse{
-		Code:    0,
-		Message: "success",
-		Token:   token,
-		User:    user,
+		Code:         0,
+		Message:      "success",
+		Token:        tokens.AccessToken,
+		User:         user,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresIn:    tokens.ExpiresIn,
+	}, nil
+}
+
+// RefreshToken 刷新令牌
+func (h *UserHandler) RefreshToken(ctx context.Context, req *userPb.RefreshTokenRequest) (*userPb.RefreshTokenResponse, error) {
+	tokens, err := h.userService.RefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &userPb.RefreshTokenResponse{
+		Code:         0,
+		Message:      "success",
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresIn:    tokens.ExpiresIn,
 	}, nil
 }
 
 // Logout 用户登出
 func (h *UserHandler) Logout(ctx context.Context, req *userPb.LogoutRequest) (*commonPb.Response, error) {
+	if err := grpcx.RequireUser(ctx, req.UserId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
 	err := h.userService.Logout(ctx, req.UserId, req.Token)
 	if err != nil {
-		return &commonPb.Response{
-			Code:    1,
-			Message: err.Error(),
-		}, nil
+		return nil, errs.GRPCError(err)
 	}
 	return &commonPb.Response{
 		Code:    0,
@@ -70,10 +86,7 @@ func (h *UserHandler) Logout(ctx context.Context, req *userPb.LogoutRequest) (*c
 func (h *UserHandler) GetUserInfo(ctx context.Context, req *userPb.GetUserInfoRequest) (*userPb.GetUserInfoResponse, error) {
 	user, err := h.userService.GetUserInfo(ctx, req.UserId)
 	if err != nil {
-		return &userPb.GetUserInfoResponse{
-			Code:    1,
-			Message: err.Error(),
-		}, nil
+		return nil, errs.GRPCError(err)
 	}
 	return &userPb.GetUserInfoResponse{
 		Code:    0,
@@ -84,12 +97,12 @@ func (h *UserHandler) GetUserInfo(ctx context.Context, req *userPb.GetUserInfoRe
 
 // UpdateUserInfo 更新用户信息
 func (h *UserHandler) UpdateUserInfo(ctx context.Context, req *userPb.UpdateUserInfoRequest) (*commonPb.Response, error) {
+	if err := grpcx.RequireUser(ctx, req.UserId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
 	err := h.userService.UpdateUserInfo(ctx, req)
 	if err != nil {
-		return &commonPb.Response{
-			Code:    1,
-			Message: err.Error(),
-		}, nil
+		return nil, errs.GRPCError(err)
 	}
 
 	return &commonPb.Response{
@@ -102,16 +115,130 @@ func (h *UserHandler) UpdateUserInfo(ctx context.Context, req *userPb.UpdateUser
 func (h *UserHandler) VerifyToken(ctx context.Context, req *userPb.VerifyTokenRequest) (*userPb.VerifyTokenResponse, error) {
 	claims, err := h.userService.VerifyToken(ctx, req.Token)
 	if err != nil {
-		return &userPb.VerifyTokenResponse{
-			Code:    1,
-			Message: err.Error(),
-		}, nil
+		return nil, errs.GRPCError(err)
 	}
 	return &userPb.VerifyTokenResponse{
+		Code:      0,
+		Message:   "success",
+		UserId:    claims.UserID,
+		Username:  claims.Username,
+		SessionId: claims.SessionID,
+	}, nil
+}
+
+// Follow 关注用户
+func (h *UserHandler) Follow(ctx context.Context, req *userPb.FollowRequest) (*commonPb.Response, error) {
+	if err := grpcx.RequireUser(ctx, req.UserId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	if err := h.userService.Follow(ctx, req.UserId, req.TargetUserId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &commonPb.Response{
+		Code:    0,
+		Message: "success",
+	}, nil
+}
+
+// Unfollow 取消关注
+func (h *UserHandler) Unfollow(ctx context.Context, req *userPb.FollowRequest) (*commonPb.Response, error) {
+	if err := grpcx.RequireUser(ctx, req.UserId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	if err := h.userService.Unfollow(ctx, req.UserId, req.TargetUserId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &commonPb.Response{
+		Code:    0,
+		Message: "success",
+	}, nil
+}
+
+// IsFollowing 批量查询关注状态
+func (h *UserHandler) IsFollowing(ctx context.Context, req *userPb.IsFollowingRequest) (*userPb.IsFollowingResponse, error) {
+	if err := grpcx.RequireUser(ctx, req.UserId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	following, err := h.userService.IsFollowing(ctx, req.UserId, req.TargetUserIds)
+	if err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &userPb.IsFollowingResponse{
+		Code:      0,
+		Message:   "success",
+		Following: following,
+	}, nil
+}
+
+// ListFollowers 查询粉丝列表
+func (h *UserHandler) ListFollowers(ctx context.Context, req *userPb.ListFollowsRequest) (*userPb.ListFollowsResponse, error) {
+	items, nextCursor, err := h.userService.ListFollowers(ctx, req.UserId, req.Cursor, req.Limit)
+	if err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &userPb.ListFollowsResponse{
+		Code:       0,
+		Message:    "success",
+		Items:      items,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// ListFollowing 查询关注列表
+func (h *UserHandler) ListFollowing(ctx context.Context, req *userPb.ListFollowsRequest) (*userPb.ListFollowsResponse, error) {
+	items, nextCursor, err := h.userService.ListFollowing(ctx, req.UserId, req.Cursor, req.Limit)
+	if err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &userPb.ListFollowsResponse{
+		Code:       0,
+		Message:    "success",
+		Items:      items,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// ListSessions 查询登录会话
+func (h *UserHandler) ListSessions(ctx context.Context, req *userPb.ListSessionsRequest) (*userPb.ListSessionsResponse, error) {
+	if err := grpcx.RequireUser(ctx, req.UserId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	sessions, err := h.userService.ListSessions(ctx, req.UserId)
+	if err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &userPb.ListSessionsResponse{
 		Code:     0,
 		Message:  "success",
-		UserId:   claims.UserID,
-		Username: claims.Username,
+		Sessions: sessions,
+	}, nil
+}
+
+// RevokeSession 吊销指定会话
+func (h *UserHandler) RevokeSession(ctx context.Context, req *userPb.RevokeSessionRequest) (*commonPb.Response, error) {
+	if err := grpcx.RequireUser(ctx, req.UserId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	if err := h.userService.RevokeSession(ctx, req.UserId, req.SessionId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &commonPb.Response{
+		Code:    0,
+		Message: "success",
+	}, nil
+}
+
+// RevokeAllSessions 吊销所有会话
+func (h *UserHandler) RevokeAllSessions(ctx context.Context, req *userPb.RevokeAllSessionsRequest) (*commonPb.Response, error) {
+	if err := grpcx.RequireUser(ctx, req.UserId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	if err := h.userService.RevokeAllSessions(ctx, req.UserId, req.ExceptSessionId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &commonPb.Response{
+		Code:    0,
+		Message: "success",
 	}, nil
 }
 
@@ -119,10 +246,7 @@ func (h *UserHandler) VerifyToken(ctx context.Context, req *userPb.VerifyTokenRe
 func (h *UserHandler) GetUsersByIds(ctx context.Context, req *userPb.GetUsersByIdsRequest) (*userPb.GetUsersByIdsResponse, error) {
 	users, err := h.userService.GetUsersByIds(ctx, req.UserIds)
 	if err != nil {
-		return &userPb.GetUsersByIdsResponse{
-			Code:    1,
-			Message: err.Error(),
-		}, nil
+		return nil, errs.GRPCError(err)
 	}
 	return &userPb.GetUsersByIdsResponse{
 		Code:    0,
@@ -131,9 +255,90 @@ func (h *UserHandler) GetUsersByIds(ctx context.Context, req *userPb.GetUsersByI
 	}, nil
 }
 
-// Health 健康检查
+// UpdateUserStatus 更新用户状态
+func (h *UserHandler) UpdateUserStatus(ctx context.Context, req *userPb.UpdateUserStatusRequest) (*userPb.UpdateUserStatusResponse, error) {
+	if err := grpcx.RequireService(ctx); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	user, err := h.userService.UpdateUserStatus(ctx, req.UserId, req.Status)
+	if err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &userPb.UpdateUserStatusResponse{
+		Code:    0,
+		Message: "success",
+		User:    user,
+	}, nil
+}
+
+// ListUsers 按条件分页查询用户
+func (h *UserHandler) ListUsers(ctx context.Context, req *userPb.ListUsersRequest) (*userPb.ListUsersResponse, error) {
+	if err := grpcx.RequireService(ctx); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	users, page, err := h.userService.ListUsers(ctx, req)
+	if err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &userPb.ListUsersResponse{
+		Code:    0,
+		Message: "success",
+		Users:   users,
+		Page:    page,
+	}, nil
+}
+
+// SendVerificationEmail 重新发送邮箱验证邮件
+func (h *UserHandler) SendVerificationEmail(ctx context.Context, req *userPb.SendVerificationEmailRequest) (*commonPb.Response, error) {
+	if err := grpcx.RequireUser(ctx, req.UserId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	if err := h.userService.SendVerificationEmail(ctx, req.UserId); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &commonPb.Response{
+		Code:    0,
+		Message: "success",
+	}, nil
+}
+
+// VerifyEmail 验证邮箱
+func (h *UserHandler) VerifyEmail(ctx context.Context, req *userPb.VerifyEmailRequest) (*commonPb.Response, error) {
+	if err := h.userService.VerifyEmail(ctx, req.Token); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &commonPb.Response{
+		Code:    0,
+		Message: "success",
+	}, nil
+}
+
+// ForgotPassword 发送重置密码邮件
+func (h *UserHandler) ForgotPassword(ctx context.Context, req *userPb.ForgotPasswordRequest) (*commonPb.Response, error) {
+	if err := h.userService.ForgotPassword(ctx, req.Email); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &commonPb.Response{
+		Code:    0,
+		Message: "success",
+	}, nil
+}
+
+// ResetPassword 重置密码
+func (h *UserHandler) ResetPassword(ctx context.Context, req *userPb.ResetPasswordRequest) (*commonPb.Response, error) {
+	if err := h.userService.ResetPassword(ctx, req.Token, req.NewPassword); err != nil {
+		return nil, errs.GRPCError(err)
+	}
+	return &commonPb.Response{
+		Code:    0,
+		Message: "success",
+	}, nil
+}
+
+// Health 健康检查，返回各依赖的最近检查结果
 func (h *UserHandler) Health(ctx context.Context, req *userPb.HealthRequest) (*userPb.HealthResponse, error) {
 	return &userPb.HealthResponse{
-		Status: "ok",
+		Status:       h.health.Status(),
+		Dependencies: h.health.Dependencies(),
 	}, nil
 }
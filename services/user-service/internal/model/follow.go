@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// Follow 关注关系，FollowerID 关注了 FolloweeID
+type Follow struct {
+	ID         int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	FollowerID int64     `gorm:"not null;uniqueIndex:uk_follower_followee,priority:1" json:"follower_id"`
+	FolloweeID int64     `gorm:"not null;uniqueIndex:uk_follower_followee,priority:2;index:idx_followee" json:"followee_id"`
+	CreatedAt  time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (Follow) TableName() string {
+	return "follows"
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"live-stream-platform/pkg/outbox"
+	"live-stream-platform/services/user-service/internal/model"
+)
+
+type FollowRepository interface {
+	// Transaction 在同一个 MySQL 事务中执行 fn，fn 内必须使用传入的 repo
+	Transaction(ctx context.Context, fn func(repo FollowRepository) error) error
+	// Create 创建关注关系，已关注时返回 false
+	Create(ctx context.Context, follow *model.Follow) (bool, error)
+	// Delete 删除关注关系，未关注时返回 false
+	Delete(ctx context.Context, followerID, followeeID int64) (bool, error)
+	// FollowingSet 返回 followeeIDs 中已被 followerID 关注的用户
+	FollowingSet(ctx context.Context, followerID int64, followeeIDs []int64) (map[int64]bool, error)
+	// ListFollowers 按关注时间倒序查询粉丝，cursor 为上一页最后一条记录的 ID
+	ListFollowers(ctx context.Context, followeeID, cursor int64, limit int) ([]*model.Follow, error)
+	// ListFollowing 按关注时间倒序查询关注的人
+	ListFollowing(ctx context.Context, followerID, cursor int64, limit int) ([]*model.Follow, error)
+	CountFollowers(ctx context.Context, followeeID int64) (int64, error)
+	CountFollowing(ctx context.Context, followerID int64) (int64, error)
+	// EnqueueEvent 把事件写入发件箱，与同一事务中的其他写入一起提交后才会发布
+	EnqueueEvent(ctx context.Context, payload proto.Message) error
+}
+
+type followRepository struct {
+	db *gorm.DB
+}
+
+func NewFollowRepository(db *gorm.DB) FollowRepository {
+	return &followRepository{
+		db: db,
+	}
+}
+
+func (fr *followRepository) Transaction(ctx context.Context, fn func(repo FollowRepository) error) error {
+	return fr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&followRepository{db: tx})
+	})
+}
+
+func (fr *followRepository) Create(ctx context.Context, follow *model.Follow) (bool, error) {
+	result := fr.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(follow)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (fr *followRepository) Delete(ctx context.Context, followerID, followeeID int64) (bool, error) {
+	result := fr.db.WithContext(ctx).
+		Where("follower_id = ? AND followee_id = ?", followerID, followeeID).
+		Delete(&model.Follow{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (fr *followRepository) FollowingSet(ctx context.Context, followerID int64, followeeIDs []int64) (map[int64]bool, error) {
+	var ids []int64
+	if err := fr.db.WithContext(ctx).Model(&model.Follow{}).
+		Where("follower_id = ? AND followee_id IN ?", followerID, followeeIDs).
+		Pluck("followee_id", &ids).Error; err != nil {
+		return nil, err
+	}
+	set := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set, nil
+}
+
+func (fr *followRepository) ListFollowers(ctx context.Context, followeeID, cursor int64, limit int) ([]*model.Follow, error) {
+	return fr.list(ctx, "followee_id = ?", followeeID, cursor, limit)
+}
+
+func (fr *followRepository) ListFollowing(ctx context.Context, followerID, cursor int64, limit int) ([]*model.Follow, error) {
+	return fr.list(ctx, "follower_id = ?", followerID, cursor, limit)
+}
+
+func (fr *followRepository) list(ctx context.Context, cond string, userID, cursor int64, limit int) ([]*model.Follow, error) {
+	query := fr.db.WithContext(ctx).Where(cond, userID)
+	if cursor > 0 {
+		query = query.Where("id < ?", cursor)
+	}
+	var follows []*model.Follow
+	if err := query.Order("id DESC").Limit(limit).Find(&follows).Error; err != nil {
+		return nil, err
+	}
+	return follows, nil
+}
+
+func (fr *followRepository) CountFollowers(ctx context.Context, followeeID int64) (int64, error) {
+	var count int64
+	err := fr.db.WithContext(ctx).Model(&model.Follow{}).Where("followee_id = ?", followeeID).Count(&count).Error
+	return count, err
+}
+
+func (fr *followRepository) CountFollowing(ctx context.Context, followerID int64) (int64, error) {
+	var count int64
+	err := fr.db.WithContext(ctx).Model(&model.Follow{}).Where("follower_id = ?", followerID).Count(&count).Error
+	return count, err
+}
+
+func (fr *followRepository) EnqueueEvent(ctx context.Context, payload proto.Message) error {
+	return outbox.Enqueue(ctx, fr.db, payload)
+}
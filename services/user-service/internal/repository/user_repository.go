@@ -4,9 +4,15 @@ import (
 	"context"
 	"gorm.io/gorm"
 	"live-stream-platform/services/user-service/internal/model"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"live-stream-platform/pkg/outbox"
 )
 
 type UserRepository interface {
+	// Transaction 在同一个 MySQL 事务中执行 fn，fn 内必须使用传入的 repo
+	Transaction(ctx context.Context, fn func(repo UserRepository) error) error
 	Create(ctx context.Context, user *model.User) error
 	GetByID(ctx context.Context, id int64) (*model.User, error)
 	GetByUsername(ctx context.Context, username string) (*model.User, error)
@@ -14,6 +20,20 @@ type UserRepository interface {
 	Update(ctx context.Context, user *model.User) error
 	GetByIDs(ctx context.Context, ids []int64) ([]*model.User, error)
 	UpdateStatus(ctx context.Context, id int64, status int) error
+	// MarkEmailVerified 标记邮箱已验证，email 与当前邮箱不一致时不更新并返回 false
+	MarkEmailVerified(ctx context.Context, id int64, email string) (bool, error)
+	UpdatePassword(ctx context.Context, id int64, passwordHash string) error
+	List(ctx context.Context, filter *UserFilter, offset, limit int) ([]*model.User, int64, error)
+	// EnqueueEvent 把事件写入发件箱，与同一事务中的其他写入一起提交后才会发布
+	EnqueueEvent(ctx context.Context, payload proto.Message) error
+}
+
+// UserFilter 用户查询条件，零值字段不参与过滤
+type UserFilter struct {
+	Keyword       string
+	Status        *int
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
 }
 
 type userRepository struct {
@@ -26,6 +46,12 @@ func NewUserRepository(db *gorm.DB) UserRepository {
 	}
 }
 
+func (ur *userRepository) Transaction(ctx context.Context, fn func(repo UserRepository) error) error {
+	return ur.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&userRepository{db: tx})
+	})
+}
+
 func (ur *userRepository) Create(ctx context.Context, user *model.User) error {
 	return ur.db.WithContext(ctx).Create(user).Error
 }
@@ -69,3 +95,46 @@ func (ur *userRepository) GetByIDs(ctx context.Context, ids []int64) ([]*model.U
 func (ur *userRepository) UpdateStatus(ctx context.Context, id int64, status int) error {
 	return ur.db.WithContext(ctx).Model(&model.User{}).Where("id = ?", id).Update("status", status).Error
 }
+
+func (ur *userRepository) MarkEmailVerified(ctx context.Context, id int64, email string) (bool, error) {
+	result := ur.db.WithContext(ctx).Model(&model.User{}).
+		Where("id = ? AND email = ?", id, email).
+		Update("email_verified", true)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (ur *userRepository) UpdatePassword(ctx context.Context, id int64, passwordHash string) error {
+	return ur.db.WithContext(ctx).Model(&model.User{}).Where("id = ?", id).Update("password_hash", passwordHash).Error
+}
+
+func (ur *userRepository) List(ctx context.Context, filter *UserFilter, offset, limit int) ([]*model.User, int64, error) {
+	query := ur.db.WithContext(ctx).Model(&model.User{})
+	if filter != nil {
+		if filter.Keyword != "" {
+			like := "%" + filter.Keyword + "%"
+			query = query.Where("username LIKE ? OR nickname LIKE ? OR email LIKE ?", like, like, like)
+		}
+		if filter.Status != nil {
+			query = query.Where("status = ?", *filter.Status)
+		}
+		if filter.CreatedAfter != nil {
+			query = query.Where("created_at >= ?", *filter.CreatedAfter)
+		}
+		if filter.CreatedBefore != nil {
+			query = query.Where("created_at < ?", *filter.CreatedBefore)
+		}
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var users []*model.User
+	if err := query.Order("id DESC").Offset(offset).Limit(limit).Find(&users).Error; err != nil {
+		return nil, 0, err
+	}
+	return users, total, nil
+}
+
+func (ur *userRepository) EnqueueEvent(ctx context.Context, payload proto.Message) error {
+	return outbox.Enqueue(ctx, ur.db, payload)
+}
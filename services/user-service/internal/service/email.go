@@ -0,0 +1,405 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	userPb "live-stream-platform/gen/proto/user"
+	"live-stream-platform/pkg/database"
+	"live-stream-platform/pkg/errs"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/pkg/mailer"
+	"live-stream-platform/pkg/metrics"
+	"live-stream-platform/pkg/utils"
+	"live-stream-platform/services/user-service/internal/model"
+)
+
+// 邮件令牌的用途，签名覆盖用途，验证邮箱的令牌不能用来重置密码
+const (
+	purposeVerifyEmail   = "verify_email"
+	purposeResetPassword = "reset_password"
+)
+
+const (
+	// emailTokenNonceLength 令牌随机部分的长度，同时作为 Redis 中一次性标记的键
+	emailTokenNonceLength = 32
+	// forgotPasswordTimeout 后台发送重置邮件的最长耗时
+	forgotPasswordTimeout = 30 * time.Second
+	// maxPendingResets 同时在后台发送的重置密码邮件上限，超过时直接丢弃
+	maxPendingResets = 64
+)
+
+// EmailOptions 邮箱验证与找回密码
+type EmailOptions struct {
+	Mailer mailer.Mailer
+	// Secret 邮件令牌的签名密钥
+	Secret []byte
+	// BaseURL 邮件中链接指向的前端地址
+	BaseURL        string
+	VerifyTokenTTL time.Duration
+	ResetTokenTTL  time.Duration
+	// ResendCooldown 同一用户两封同类邮件的最小间隔
+	ResendCooldown time.Duration
+}
+
+// emailToken 邮件令牌内容，以 base64url(JSON).base64url(HMAC) 的形式放进链接；
+// 签名防止伪造，Redis 中的一次性标记保证只能使用一次
+type emailToken struct {
+	Purpose string `json:"p"`
+	UserID  int64  `json:"u"`
+	// Email 签发时的邮箱，邮箱变更后验证令牌失效
+	Email string `json:"e"`
+	// Password 签发时密码哈希的摘要，密码修改后尚未使用的重置令牌一并失效
+	Password  string `json:"h,omitempty"`
+	Nonce     string `json:"n"`
+	ExpiresAt int64  `json:"x"`
+}
+
+// SendVerificationEmail 重新发送邮箱验证邮件
+func (s *userService) SendVerificationEmail(ctx context.Context, userID int64) error {
+	ctx = database.WithPrimary(ctx)
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrUserNotFound
+		}
+		return errs.Wrap(err, "failed to get user")
+	}
+	if user.EmailVerified {
+		return ErrEmailAlreadyVerified
+	}
+	if user.Status != model.UserStatusNormal {
+		return ErrUserDisabled
+	}
+	ok, err := s.acquireEmailCooldown(ctx, purposeVerifyEmail, user.ID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrEmailCooldown
+	}
+	if err := s.sendVerificationEmail(ctx, user); err != nil {
+		// 发送失败不占用冷却时间，用户可以立即重试
+		s.releaseEmailCooldown(ctx, purposeVerifyEmail, user.ID)
+		return errs.Wrap(err, "failed to send verification email")
+	}
+	return nil
+}
+
+// OnUserRegistered 消费 user.registered 事件，向新用户发送验证邮件；返回错误时事件会被重试
+func (s *userService) OnUserRegistered(ctx context.Context, event *userPb.UserRegistered) error {
+	ctx = database.WithPrimary(ctx)
+	user, err := s.userRepo.GetByID(ctx, event.UserId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	if user.EmailVerified || user.Status != model.UserStatusNormal {
+		return nil
+	}
+	// 注册邮件同样占用冷却时间，避免刚注册就被重复请求
+	if _, err := s.acquireEmailCooldown(ctx, purposeVerifyEmail, user.ID); err != nil {
+		return err
+	}
+	return s.sendVerificationEmail(ctx, user)
+}
+
+// VerifyEmail 使用邮件中的令牌验证邮箱，已验证的邮箱再次验证不报错
+func (s *userService) VerifyEmail(ctx context.Context, token string) error {
+	t, err := s.parseEmailToken(purposeVerifyEmail, token)
+	if err != nil {
+		return err
+	}
+	ctx = database.WithPrimary(ctx)
+	user, err := s.userRepo.GetByID(ctx, t.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrInvalidEmailToken
+		}
+		return errs.Wrap(err, "failed to get user")
+	}
+	if user.Email != t.Email {
+		return ErrInvalidEmailToken
+	}
+	if user.EmailVerified {
+		return nil
+	}
+	if err := s.useEmailToken(ctx, t); err != nil {
+		return err
+	}
+	ok, err := s.userRepo.MarkEmailVerified(ctx, user.ID, t.Email)
+	if err != nil {
+		return errs.Wrap(err, "failed to verify email")
+	}
+	if !ok {
+		return ErrInvalidEmailToken
+	}
+	user.EmailVerified = true
+	s.cacheUsers(ctx, []int64{user.ID}, []*model.User{user})
+	return nil
+}
+
+// ForgotPassword 发送重置密码邮件。邮箱未注册、账号被禁用或处于冷却期时同样返回成功，
+// 且邮件在后台发送，调用方无法通过结果或耗时判断邮箱是否注册
+func (s *userService) ForgotPassword(ctx context.Context, email string) error {
+	if !utils.ValidateEmail(email) {
+		return invalidParam("email", "invalid email")
+	}
+	select {
+	case s.resetSlots <- struct{}{}:
+	default:
+		// 丢弃时同样返回成功，调用方可以稍后重试
+		logger.Warn(ctx, "Too many pending password reset emails, dropped")
+		return nil
+	}
+	go func() {
+		defer func() { <-s.resetSlots }()
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), forgotPasswordTimeout)
+		defer cancel()
+		// 日志中不记录邮箱，避免泄露用户信息
+		if err := s.sendPasswordReset(ctx, email); err != nil {
+			logger.Warn(ctx, "Failed to send password reset email", logger.Err(err))
+		}
+	}()
+	return nil
+}
+
+func (s *userService) sendPasswordReset(ctx context.Context, email string) error {
+	ctx = database.WithPrimary(ctx)
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	if user.Status != model.UserStatusNormal {
+		return nil
+	}
+	ok, err := s.acquireEmailCooldown(ctx, purposeResetPassword, user.ID)
+	if err != nil || !ok {
+		return err
+	}
+	token, err := s.issueEmailToken(ctx, purposeResetPassword, user, s.email.ResetTokenTTL)
+	if err != nil {
+		s.releaseEmailCooldown(ctx, purposeResetPassword, user.ID)
+		return err
+	}
+	err = s.sendMail(ctx, purposeResetPassword, &mailer.Message{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Text: fmt.Sprintf("Hi %s,\n\n"+
+			"We received a request to reset the password of your account. Open the link below to choose a new password:\n\n"+
+			"%s\n\n"+
+			"The link expires in %s and can only be used once. If you did not request this, you can ignore this email.\n",
+			displayName(user), s.emailLink("/reset-password", token), formatTTL(s.email.ResetTokenTTL)),
+	})
+	if err != nil {
+		s.releaseEmailCooldown(ctx, purposeResetPassword, user.ID)
+	}
+	return err
+}
+
+// ResetPassword 使用邮件中的令牌重置密码，成功后吊销该用户的所有会话
+func (s *userService) ResetPassword(ctx context.Context, token, newPassword string) error {
+	if !utils.ValidatePassword(newPassword) {
+		return invalidParam("new_password", "invalid password: at least 8 characters with uppercase, lowercase and number")
+	}
+	t, err := s.parseEmailToken(purposeResetPassword, token)
+	if err != nil {
+		return err
+	}
+	ctx = database.WithPrimary(ctx)
+	user, err := s.userRepo.GetByID(ctx, t.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrInvalidEmailToken
+		}
+		return errs.Wrap(err, "failed to get user")
+	}
+	if user.Email != t.Email || !hmac.Equal([]byte(passwordFingerprint(user.PasswordHash)), []byte(t.Password)) {
+		return ErrInvalidEmailToken
+	}
+	if user.Status != model.UserStatusNormal {
+		return ErrUserDisabled
+	}
+	if err := s.useEmailToken(ctx, t); err != nil {
+		return err
+	}
+	passwordHash, err := utils.HashPassword(newPassword)
+	if err != nil {
+		return errs.Wrap(err, "failed to hash password")
+	}
+	if err := s.userRepo.UpdatePassword(ctx, user.ID, passwordHash); err != nil {
+		return errs.Wrap(err, "failed to update password")
+	}
+	// 密码可能已经泄露，所有设备都需要用新密码重新登录
+	return s.RevokeAllSessions(ctx, user.ID, "")
+}
+
+func (s *userService) sendVerificationEmail(ctx context.Context, user *model.User) error {
+	token, err := s.issueEmailToken(ctx, purposeVerifyEmail, user, s.email.VerifyTokenTTL)
+	if err != nil {
+		return err
+	}
+	return s.sendMail(ctx, purposeVerifyEmail, &mailer.Message{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Text: fmt.Sprintf("Hi %s,\n\n"+
+			"Please confirm that this is your email address by opening the link below:\n\n"+
+			"%s\n\n"+
+			"The link expires in %s. If you did not create an account, you can ignore this email.\n",
+			displayName(user), s.emailLink("/verify-email", token), formatTTL(s.email.VerifyTokenTTL)),
+	})
+}
+
+func (s *userService) sendMail(ctx context.Context, purpose string, msg *mailer.Message) error {
+	err := s.email.Mailer.Send(ctx, msg)
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	metrics.MailsSent.WithLabelValues(purpose, result).Inc()
+	return err
+}
+
+// issueEmailToken 签发令牌，并在 Redis 中登记一次性标记，有效期与令牌相同
+func (s *userService) issueEmailToken(ctx context.Context, purpose string, user *model.User, ttl time.Duration) (string, error) {
+	nonce, err := utils.GenerateRandomString(emailTokenNonceLength)
+	if err != nil {
+		return "", errs.Wrap(err, "failed to generate email token")
+	}
+	t := &emailToken{
+		Purpose:   purpose,
+		UserID:    user.ID,
+		Email:     user.Email,
+		Nonce:     nonce,
+		ExpiresAt: time.Now().Add(ttl).Unix(),
+	}
+	if purpose == purposeResetPassword {
+		t.Password = passwordFingerprint(user.PasswordHash)
+	}
+	payload, err := json.Marshal(t)
+	if err != nil {
+		return "", errs.Wrap(err, "failed to encode email token")
+	}
+	if err := s.redisClient.Set(ctx, emailTokenKey(nonce), user.ID, ttl).Err(); err != nil {
+		return "", errs.Wrap(err, "failed to store email token")
+	}
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(s.signEmailToken(payload)), nil
+}
+
+// parseEmailToken 校验签名、用途与有效期，不消耗令牌
+func (s *userService) parseEmailToken(purpose, token string) (*emailToken, error) {
+	encoded, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrInvalidEmailToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidEmailToken
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(mac, s.signEmailToken(payload)) {
+		return nil, ErrInvalidEmailToken
+	}
+	var t emailToken
+	if err := json.Unmarshal(payload, &t); err != nil {
+		return nil, ErrInvalidEmailToken
+	}
+	if t.Purpose != purpose || t.Nonce == "" || time.Now().Unix() >= t.ExpiresAt {
+		return nil, ErrInvalidEmailToken
+	}
+	return &t, nil
+}
+
+// useEmailToken 原子地删除一次性标记，标记不存在说明令牌已使用或已过期
+func (s *userService) useEmailToken(ctx context.Context, t *emailToken) error {
+	value, err := s.redisClient.GetDel(ctx, emailTokenKey(t.Nonce)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return ErrInvalidEmailToken
+		}
+		return errs.Wrap(err, "failed to consume email token")
+	}
+	if value != strconv.FormatInt(t.UserID, 10) {
+		return ErrInvalidEmailToken
+	}
+	return nil
+}
+
+func (s *userService) signEmailToken(payload []byte) []byte {
+	mac := hmac.New(sha256.New, s.email.Secret)
+	mac.Write([]byte("email-token."))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// acquireEmailCooldown 占用发送冷却时间，冷却期内返回 false
+func (s *userService) acquireEmailCooldown(ctx context.Context, purpose string, userID int64) (bool, error) {
+	if s.email.ResendCooldown <= 0 {
+		return true, nil
+	}
+	ok, err := s.redisClient.SetNX(ctx, emailCooldownKey(purpose, userID), 1, s.email.ResendCooldown).Result()
+	if err != nil {
+		return false, errs.Wrap(err, "failed to check email cooldown")
+	}
+	return ok, nil
+}
+
+func (s *userService) releaseEmailCooldown(ctx context.Context, purpose string, userID int64) {
+	if err := s.redisClient.Del(ctx, emailCooldownKey(purpose, userID)).Err(); err != nil {
+		logger.Warn(ctx, "Failed to release email cooldown", logger.Err(err))
+	}
+}
+
+func (s *userService) emailLink(path, token string) string {
+	return strings.TrimRight(s.email.BaseURL, "/") + path + "?token=" + url.QueryEscape(token)
+}
+
+// passwordFingerprint 密码哈希的摘要，令牌中不直接携带哈希
+func passwordFingerprint(passwordHash string) string {
+	sum := sha256.Sum256([]byte(passwordHash))
+	return hex.EncodeToString(sum[:8])
+}
+
+// formatTTL 邮件正文中的有效期，如 24 hours、30 minutes
+func formatTTL(d time.Duration) string {
+	switch {
+	case d >= time.Hour && d%time.Hour == 0:
+		return fmt.Sprintf("%d hours", d/time.Hour)
+	case d >= time.Minute:
+		return fmt.Sprintf("%d minutes", d/time.Minute)
+	default:
+		return d.String()
+	}
+}
+
+func displayName(user *model.User) string {
+	if user.Nickname != "" {
+		return user.Nickname
+	}
+	return user.Username
+}
+
+func emailTokenKey(nonce string) string {
+	return "email:token:" + nonce
+}
+
+func emailCooldownKey(purpose string, userID int64) string {
+	return fmt.Sprintf("email:cooldown:%s:%d", purpose, userID)
+}
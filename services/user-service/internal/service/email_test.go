@@ -0,0 +1,88 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// signedEmailToken 按 issueEmailToken 的格式编码并签名，不登记 Redis 中的一次性标记
+func signedEmailToken(t *testing.T, s *userService, payload []byte) string {
+	t.Helper()
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(s.signEmailToken(payload))
+}
+
+func encodeEmailToken(t *testing.T, s *userService, token emailToken) string {
+	t.Helper()
+	payload, err := json.Marshal(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signedEmailToken(t, s, payload)
+}
+
+func TestParseEmailToken(t *testing.T) {
+	s := &userService{email: EmailOptions{Secret: []byte("mail-token-secret")}}
+	other := &userService{email: EmailOptions{Secret: []byte("another-secret")}}
+	valid := emailToken{
+		Purpose:   purposeResetPassword,
+		UserID:    42,
+		Email:     "alice@example.com",
+		Password:  "0123456789abcdef",
+		Nonce:     "nonce",
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+	}
+	with := func(modify func(*emailToken)) emailToken {
+		token := valid
+		modify(&token)
+		return token
+	}
+	validToken := encodeEmailToken(t, s, valid)
+	encoded, sig, _ := strings.Cut(validToken, ".")
+
+	// 篡改载荷后沿用原签名
+	tampered, err := json.Marshal(with(func(tok *emailToken) { tok.UserID = 1 }))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		purpose string
+		token   string
+		wantErr bool
+	}{
+		{"valid", purposeResetPassword, validToken, false},
+		{"wrong purpose", purposeVerifyEmail, validToken, true},
+		{"expired", purposeResetPassword, encodeEmailToken(t, s, with(func(tok *emailToken) { tok.ExpiresAt = time.Now().Unix() - 1 })), true},
+		{"expires now", purposeResetPassword, encodeEmailToken(t, s, with(func(tok *emailToken) { tok.ExpiresAt = time.Now().Unix() })), true},
+		{"empty nonce", purposeResetPassword, encodeEmailToken(t, s, with(func(tok *emailToken) { tok.Nonce = "" })), true},
+		{"tampered payload", purposeResetPassword, base64.RawURLEncoding.EncodeToString(tampered) + "." + sig, true},
+		{"tampered signature", purposeResetPassword, encoded + "." + base64.RawURLEncoding.EncodeToString([]byte("forged")), true},
+		{"signed with another secret", purposeResetPassword, encodeEmailToken(t, other, valid), true},
+		{"missing signature", purposeResetPassword, encoded, true},
+		{"invalid base64", purposeResetPassword, "!!!." + sig, true},
+		{"signed non-json payload", purposeResetPassword, signedEmailToken(t, s, []byte("not json")), true},
+		{"empty", purposeResetPassword, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.parseEmailToken(tt.purpose, tt.token)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidEmailToken) {
+					t.Fatalf("err = %v, want ErrInvalidEmailToken", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if *got != valid {
+				t.Errorf("token = %+v, want %+v", *got, valid)
+			}
+		})
+	}
+}
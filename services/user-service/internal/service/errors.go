@@ -0,0 +1,29 @@
+package service
+
+import "live-stream-platform/pkg/errs"
+
+// 用户服务错误码（10xxx），对外发布后数值不可再修改
+var (
+	ErrInvalidParams        = errs.InvalidArgument(10001, "INVALID_PARAMS", "invalid parameters")
+	ErrUserNotFound         = errs.NotFound(10002, "USER_NOT_FOUND", "user not found")
+	ErrEmailExists          = errs.AlreadyExists(10003, "EMAIL_EXISTS", "email already exists")
+	ErrUsernameExists       = errs.AlreadyExists(10004, "USERNAME_EXISTS", "username already exists")
+	ErrInvalidCredentials   = errs.Unauthenticated(10005, "INVALID_CREDENTIALS", "username or password incorrect")
+	ErrUserDisabled         = errs.PermissionDenied(10006, "USER_DISABLED", "user account is disabled")
+	ErrInvalidToken         = errs.Unauthenticated(10007, "INVALID_TOKEN", "invalid or expired token")
+	ErrTokenRevoked         = errs.Unauthenticated(10008, "TOKEN_REVOKED", "token has been revoked")
+	ErrTokenNotOwned        = errs.PermissionDenied(10009, "TOKEN_NOT_OWNED", "token does not belong to user")
+	ErrInvalidRefreshToken  = errs.Unauthenticated(10010, "INVALID_REFRESH_TOKEN", "invalid refresh token")
+	ErrRefreshTokenReused   = errs.Unauthenticated(10011, "REFRESH_TOKEN_REUSED", "refresh token reused, session revoked")
+	ErrSessionExpired       = errs.Unauthenticated(10012, "SESSION_EXPIRED", "session has been revoked or expired")
+	ErrSessionNotFound      = errs.NotFound(10013, "SESSION_NOT_FOUND", "session not found")
+	ErrCannotFollowSelf     = errs.InvalidArgument(10014, "CANNOT_FOLLOW_SELF", "cannot follow yourself")
+	ErrInvalidEmailToken    = errs.InvalidArgument(10015, "INVALID_EMAIL_TOKEN", "invalid or expired link")
+	ErrEmailAlreadyVerified = errs.AlreadyExists(10016, "EMAIL_ALREADY_VERIFIED", "email already verified")
+	ErrEmailCooldown        = errs.ResourceExhausted(10017, "EMAIL_COOLDOWN", "email sent too recently, please try again later")
+)
+
+// invalidParam 单个字段校验失败
+func invalidParam(field, description string) error {
+	return ErrInvalidParams.WithMessage(description).WithField(field, description)
+}
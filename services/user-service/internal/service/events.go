@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/protobuf/proto"
+	userPb "live-stream-platform/gen/proto/user"
+	"live-stream-platform/pkg/events"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/services/user-service/internal/model"
+)
+
+// maxPendingEvents 同时在后台等待 broker 确认的事件上限，超过时直接丢弃
+const maxPendingEvents = 256
+
+// publishEvent 在后台发布不伴随数据库写入的事件（如登录），不等待 broker 确认，失败只记录日志，不影响请求结果；
+// 伴随写入的事件通过 UserRepository.EnqueueEvent 写入发件箱
+func (s *userService) publishEvent(ctx context.Context, payload proto.Message) {
+	select {
+	case s.eventSlots <- struct{}{}:
+	default:
+		logger.Warn(ctx, "Too many pending user events, dropped", slog.String("type", string(proto.MessageName(payload))))
+		return
+	}
+	// 请求返回后 ctx 会被取消，保留其中的 trace 与请求 ID
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer func() { <-s.eventSlots }()
+		if err := events.Publish(ctx, payload); err != nil {
+			logger.Warn(ctx, "Failed to publish user event", logger.Err(err))
+		}
+	}()
+}
+
+func userUpdatedEvent(user *model.User) *userPb.UserUpdated {
+	return &userPb.UserUpdated{
+		UserId:   user.ID,
+		Nickname: user.Nickname,
+		Gender:   int32(user.Gender),
+		Avatar:   user.Avatar,
+		Status:   int32(user.Status),
+	}
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	commonPb "live-stream-platform/gen/proto/common"
+	userPb "live-stream-platform/gen/proto/user"
+	"live-stream-platform/pkg/errs"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/services/user-service/internal/model"
+	"live-stream-platform/services/user-service/internal/repository"
+)
+
+const (
+	// maxFollowCheckIDs 批量查询关注状态的最大数量
+	maxFollowCheckIDs = 100
+	// followCountTTL 关注计数缓存有效期，过期后从数据库重建以修正偏差
+	followCountTTL = 24 * time.Hour
+)
+
+// incrIfExistsScript 计数缓存存在时才增减，缓存缺失时由读取方从数据库重建
+var incrIfExistsScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return redis.call('HINCRBY', KEYS[1], ARGV[1], ARGV[2])
+end
+return false
+`)
+
+// Follow 关注用户，重复关注不报错。关注关系与 user.followed 事件在同一个事务中写入
+func (s *userService) Follow(ctx context.Context, userID, targetUserID int64) error {
+	if userID == targetUserID {
+		return ErrCannotFollowSelf
+	}
+	target, err := s.userRepo.GetByID(ctx, targetUserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrUserNotFound
+		}
+		return errs.Wrap(err, "failed to get user")
+	}
+	if target.Status != model.UserStatusNormal {
+		return ErrUserDisabled
+	}
+	follow := &model.Follow{FollowerID: userID, FolloweeID: targetUserID}
+	created := false
+	err = s.followRepo.Transaction(ctx, func(repo repository.FollowRepository) error {
+		var err error
+		if created, err = repo.Create(ctx, follow); err != nil || !created {
+			return err
+		}
+		return repo.EnqueueEvent(ctx, &userPb.UserFollowed{
+			FollowerId: follow.FollowerID,
+			FolloweeId: follow.FolloweeID,
+			FollowedAt: follow.CreatedAt.Unix(),
+		})
+	})
+	if err != nil {
+		return errs.Wrap(err, "failed to follow")
+	}
+	if created {
+		s.adjustFollowCounts(ctx, userID, targetUserID, 1)
+	}
+	return nil
+}
+
+// Unfollow 取消关注，未关注时不报错
+func (s *userService) Unfollow(ctx context.Context, userID, targetUserID int64) error {
+	deleted := false
+	err := s.followRepo.Transaction(ctx, func(repo repository.FollowRepository) error {
+		var err error
+		if deleted, err = repo.Delete(ctx, userID, targetUserID); err != nil || !deleted {
+			return err
+		}
+		return repo.EnqueueEvent(ctx, &userPb.UserUnfollowed{
+			FollowerId: userID,
+			FolloweeId: targetUserID,
+		})
+	})
+	if err != nil {
+		return errs.Wrap(err, "failed to unfollow")
+	}
+	if deleted {
+		s.adjustFollowCounts(ctx, userID, targetUserID, -1)
+	}
+	return nil
+}
+
+// IsFollowing 批量查询 userID 是否关注了目标用户
+func (s *userService) IsFollowing(ctx context.Context, userID int64, targetUserIDs []int64) (map[int64]bool, error) {
+	if len(targetUserIDs) > maxFollowCheckIDs {
+		return nil, invalidParam("target_user_ids", fmt.Sprintf("too many user ids, at most %d", maxFollowCheckIDs))
+	}
+	result := make(map[int64]bool, len(targetUserIDs))
+	if len(targetUserIDs) == 0 {
+		return result, nil
+	}
+	set, err := s.followRepo.FollowingSet(ctx, userID, targetUserIDs)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to check following")
+	}
+	for _, id := range targetUserIDs {
+		result[id] = set[id]
+	}
+	return result, nil
+}
+
+// ListFollowers 查询粉丝列表，返回下一页游标，0 表示没有更多
+func (s *userService) ListFollowers(ctx context.Context, userID, cursor int64, limit int32) ([]*userPb.FollowItem, int64, error) {
+	size := normalizeLimit(limit)
+	follows, err := s.followRepo.ListFollowers(ctx, userID, cursor, size+1)
+	if err != nil {
+		return nil, 0, errs.Wrap(err, "failed to list followers")
+	}
+	return s.toFollowItems(ctx, follows, size, func(f *model.Follow) int64 { return f.FollowerID })
+}
+
+// ListFollowing 查询关注列表，返回下一页游标，0 表示没有更多
+func (s *userService) ListFollowing(ctx context.Context, userID, cursor int64, limit int32) ([]*userPb.FollowItem, int64, error) {
+	size := normalizeLimit(limit)
+	follows, err := s.followRepo.ListFollowing(ctx, userID, cursor, size+1)
+	if err != nil {
+		return nil, 0, errs.Wrap(err, "failed to list following")
+	}
+	return s.toFollowItems(ctx, follows, size, func(f *model.Follow) int64 { return f.FolloweeID })
+}
+
+// toFollowItems 多查的一条用于判断是否还有下一页
+func (s *userService) toFollowItems(ctx context.Context, follows []*model.Follow, size int, userOf func(*model.Follow) int64) ([]*userPb.FollowItem, int64, error) {
+	var nextCursor int64
+	if len(follows) > size {
+		follows = follows[:size]
+		nextCursor = follows[size-1].ID
+	}
+	userIDs := make([]int64, 0, len(follows))
+	for _, follow := range follows {
+		userIDs = append(userIDs, userOf(follow))
+	}
+	users, err := s.GetUsersByIds(ctx, userIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+	userMap := make(map[int64]*commonPb.UserInfo, len(users))
+	for _, user := range users {
+		userMap[user.Id] = user
+	}
+	items := make([]*userPb.FollowItem, 0, len(follows))
+	for _, follow := range follows {
+		user, ok := userMap[userOf(follow)]
+		if !ok {
+			continue
+		}
+		items = append(items, &userPb.FollowItem{
+			User:       user,
+			FollowedAt: follow.CreatedAt.Unix(),
+		})
+	}
+	return items, nextCursor, nil
+}
+
+// adjustFollowCounts 关注关系变化后更新双方的计数缓存
+func (s *userService) adjustFollowCounts(ctx context.Context, followerID, followeeID int64, delta int) {
+	if err := incrIfExistsScript.Run(ctx, s.redisClient, []string{followCountKey(followerID)}, "following", delta).Err(); err != nil && !errors.Is(err, redis.Nil) {
+		logger.Warn(ctx, "Failed to update following count", logger.Err(err))
+	}
+	if err := incrIfExistsScript.Run(ctx, s.redisClient, []string{followCountKey(followeeID)}, "followers", delta).Err(); err != nil && !errors.Is(err, redis.Nil) {
+		logger.Warn(ctx, "Failed to update follower count", logger.Err(err))
+	}
+}
+
+// fillFollowCounts 填充用户的粉丝数与关注数，缓存缺失时从数据库重建
+func (s *userService) fillFollowCounts(ctx context.Context, users []*commonPb.UserInfo) {
+	if len(users) == 0 {
+		return
+	}
+	pipe := s.redisClient.Pipeline()
+	cmds := make([]*redis.SliceCmd, len(users))
+	for i, user := range users {
+		cmds[i] = pipe.HMGet(ctx, followCountKey(user.Id), "followers", "following")
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		logger.Warn(ctx, "Failed to get follow counts", logger.Err(err))
+	}
+	for i, user := range users {
+		values := cmds[i].Val()
+		if len(values) == 2 && values[0] != nil && values[1] != nil {
+			user.FollowerCount = parseCount(values[0])
+			user.FollowingCount = parseCount(values[1])
+			continue
+		}
+		if err := s.loadFollowCounts(ctx, user); err != nil {
+			logger.Warn(ctx, "Failed to load follow counts", logger.Err(err))
+		}
+	}
+}
+
+// loadFollowCounts 从数据库统计计数并写回缓存
+func (s *userService) loadFollowCounts(ctx context.Context, user *commonPb.UserInfo) error {
+	followers, err := s.followRepo.CountFollowers(ctx, user.Id)
+	if err != nil {
+		return err
+	}
+	following, err := s.followRepo.CountFollowing(ctx, user.Id)
+	if err != nil {
+		return err
+	}
+	user.FollowerCount = followers
+	user.FollowingCount = following
+	key := followCountKey(user.Id)
+	pipe := s.redisClient.TxPipeline()
+	pipe.HSet(ctx, key, "followers", followers, "following", following)
+	pipe.Expire(ctx, key, followCountTTL)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func normalizeLimit(limit int32) int {
+	if limit <= 0 || limit > maxPageSize {
+		return defaultPageSize
+	}
+	return int(limit)
+}
+
+func parseCount(v any) int64 {
+	str, _ := v.(string)
+	n, _ := strconv.ParseInt(str, 10, 64)
+	return n
+}
+
+func followCountKey(userID int64) string {
+	return fmt.Sprintf("user:follow_count:%d", userID)
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/redis/go-redis/v9"
+	userPb "live-stream-platform/gen/proto/user"
+	"live-stream-platform/pkg/errs"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/pkg/utils"
+)
+
+const (
+	// sessionIDLength 会话 ID 长度
+	sessionIDLength = 32
+	// sessionTouchInterval 最近活跃时间的最小更新间隔，避免每次鉴权都写 Redis
+	sessionTouchInterval = time.Minute
+	maxDeviceNameLength  = 64
+	maxUserAgentLength   = 256
+)
+
+// Session 登录会话（设备），与刷新令牌族一一对应
+type Session struct {
+	ID         string
+	UserID     int64
+	DeviceName string
+	UserAgent  string
+	IP         string
+	// JTI 会话当前有效的访问令牌 ID
+	JTI        string
+	CreatedAt  int64
+	LastSeenAt int64
+}
+
+// touchSessionScript 会话存在时才更新最近活跃时间。
+// 直接 HSET 会在会话被吊销后重新创建一个没有 TTL 的残缺会话
+var touchSessionScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'last_seen_at', ARGV[1])
+return 1
+`)
+
+// createSession 登录时创建会话
+func (s *userService) createSession(ctx context.Context, userID int64, req *userPb.LoginRequest) (string, error) {
+	sessionID, err := utils.GenerateRandomString(sessionIDLength)
+	if err != nil {
+		return "", errs.Wrap(err, "failed to generate session id")
+	}
+	now := time.Now().Unix()
+	key := sessionKey(sessionID)
+	indexKey := userSessionsKey(userID)
+	pipe := s.redisClient.TxPipeline()
+	pipe.HSet(ctx, key,
+		"user_id", userID,
+		"device_name", truncate(req.DeviceName, maxDeviceNameLength),
+		"user_agent", truncate(req.UserAgent, maxUserAgentLength),
+		"ip", req.Ip,
+		"created_at", now,
+		"last_seen_at", now,
+	)
+	pipe.Expire(ctx, key, s.refreshExpire)
+	pipe.ZAdd(ctx, indexKey, redis.Z{Score: float64(now), Member: sessionID})
+	pipe.Expire(ctx, indexKey, s.refreshExpire)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return "", errs.Wrap(err, "failed to create session")
+	}
+	return sessionID, nil
+}
+
+// getSession 获取会话，不存在（已吊销或过期）时返回 ErrSessionExpired
+func (s *userService) getSession(ctx context.Context, sessionID string) (*Session, error) {
+	if sessionID == "" {
+		return nil, ErrSessionExpired
+	}
+	fields, err := s.redisClient.HGetAll(ctx, sessionKey(sessionID)).Result()
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to get session")
+	}
+	if len(fields) == 0 {
+		return nil, ErrSessionExpired
+	}
+	return parseSession(sessionID, fields), nil
+}
+
+// touchSession 更新会话最近活跃时间
+func (s *userService) touchSession(ctx context.Context, session *Session) {
+	now := time.Now()
+	if now.Unix()-session.LastSeenAt < int64(sessionTouchInterval/time.Second) {
+		return
+	}
+	if err := touchSessionScript.Run(ctx, s.redisClient, []string{sessionKey(session.ID)}, now.Unix()).Err(); err != nil {
+		logger.Warn(ctx, "Failed to touch session", logger.Err(err))
+	}
+}
+
+// ListSessions 查询用户的所有有效会话，按创建时间倒序
+func (s *userService) ListSessions(ctx context.Context, userID int64) ([]*userPb.SessionInfo, error) {
+	indexKey := userSessionsKey(userID)
+	sessionIDs, err := s.redisClient.ZRevRange(ctx, indexKey, 0, -1).Result()
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list sessions")
+	}
+	if len(sessionIDs) == 0 {
+		return []*userPb.SessionInfo{}, nil
+	}
+	pipe := s.redisClient.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, len(sessionIDs))
+	for i, sessionID := range sessionIDs {
+		cmds[i] = pipe.HGetAll(ctx, sessionKey(sessionID))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, errs.Wrap(err, "failed to get sessions")
+	}
+
+	sessions := make([]*userPb.SessionInfo, 0, len(sessionIDs))
+	var expired []any
+	for i, cmd := range cmds {
+		fields := cmd.Val()
+		if len(fields) == 0 {
+			// 会话已过期，顺便清理索引
+			expired = append(expired, sessionIDs[i])
+			continue
+		}
+		session := parseSession(sessionIDs[i], fields)
+		sessions = append(sessions, &userPb.SessionInfo{
+			SessionId:  session.ID,
+			DeviceName: session.DeviceName,
+			UserAgent:  session.UserAgent,
+			Ip:         session.IP,
+			CreatedAt:  session.CreatedAt,
+			LastSeenAt: session.LastSeenAt,
+		})
+	}
+	if len(expired) > 0 {
+		if err := s.redisClient.ZRem(ctx, indexKey, expired...).Err(); err != nil {
+			logger.Warn(ctx, "Failed to prune sessions", logger.Err(err))
+		}
+	}
+	return sessions, nil
+}
+
+// RevokeSession 吊销用户的指定会话
+func (s *userService) RevokeSession(ctx context.Context, userID int64, sessionID string) error {
+	session, err := s.getSession(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, ErrSessionExpired) {
+			return ErrSessionNotFound
+		}
+		return err
+	}
+	if session.UserID != userID {
+		return ErrSessionNotFound
+	}
+	return s.revokeSession(ctx, userID, sessionID)
+}
+
+// RevokeAllSessions 吊销用户的所有会话，exceptSessionID 不为空时保留该会话
+func (s *userService) RevokeAllSessions(ctx context.Context, userID int64, exceptSessionID string) error {
+	indexKey := userSessionsKey(userID)
+	sessionIDs, err := s.redisClient.ZRange(ctx, indexKey, 0, -1).Result()
+	if err != nil {
+		return errs.Wrap(err, "failed to list sessions")
+	}
+	pipe := s.redisClient.TxPipeline()
+	for _, sessionID := range sessionIDs {
+		if sessionID == exceptSessionID {
+			continue
+		}
+		pipe.Del(ctx, sessionKey(sessionID))
+		pipe.ZRem(ctx, indexKey, sessionID)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errs.Wrap(err, "failed to revoke sessions")
+	}
+	return nil
+}
+
+// revokeSession 删除会话，会话下的访问令牌与刷新令牌随之失效
+func (s *userService) revokeSession(ctx context.Context, userID int64, sessionID string) error {
+	if sessionID == "" {
+		return nil
+	}
+	pipe := s.redisClient.TxPipeline()
+	pipe.Del(ctx, sessionKey(sessionID))
+	pipe.ZRem(ctx, userSessionsKey(userID), sessionID)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errs.Wrap(err, "failed to revoke session")
+	}
+	return nil
+}
+
+func parseSession(sessionID string, fields map[string]string) *Session {
+	userID, _ := strconv.ParseInt(fields["user_id"], 10, 64)
+	createdAt, _ := strconv.ParseInt(fields["created_at"], 10, 64)
+	lastSeenAt, _ := strconv.ParseInt(fields["last_seen_at"], 10, 64)
+	return &Session{
+		ID:         sessionID,
+		UserID:     userID,
+		DeviceName: fields["device_name"],
+		UserAgent:  fields["user_agent"],
+		IP:         fields["ip"],
+		JTI:        fields["jti"],
+		CreatedAt:  createdAt,
+		LastSeenAt: lastSeenAt,
+	}
+}
+
+// truncate 按字符截断字符串
+func truncate(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return string([]rune(s)[:max])
+}
+
+func sessionKey(sessionID string) string {
+	return "session:" + sessionID
+}
+
+func userSessionsKey(userID int64) string {
+	return fmt.Sprintf("user:sessions:%d", userID)
+}
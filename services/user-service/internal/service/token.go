@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"live-stream-platform/pkg/errs"
+	"live-stream-platform/pkg/jwt"
+	"live-stream-platform/pkg/utils"
+	"live-stream-platform/services/user-service/internal/model"
+)
+
+// refreshTokenLength 刷新令牌长度（不透明随机串）
+const refreshTokenLength = 64
+
+// TokenPair 访问令牌与刷新令牌
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	// ExpiresIn 访问令牌有效期（秒）
+	ExpiresIn int64
+}
+
+// consumeRefreshScript 原子地把刷新令牌标记为已使用，返回 {使用次数, user_id, session_id}；
+// 使用次数大于 1 说明旧令牌被重放
+var consumeRefreshScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return false
+end
+local used = redis.call('HINCRBY', KEYS[1], 'used', 1)
+return {used, redis.call('HGET', KEYS[1], 'user_id'), redis.call('HGET', KEYS[1], 'session_id')}
+`)
+
+// saveTokensScript 会话存在时才记录新签发的令牌并顺延会话、刷新令牌与会话索引的有效期，返回 0 表示会话已被吊销。
+// KEYS: 会话、刷新令牌、用户会话索引；ARGV: 有效期（秒）、jti、当前时间、user_id、session_id
+var saveTokensScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'jti', ARGV[2], 'last_seen_at', ARGV[3])
+redis.call('EXPIRE', KEYS[1], ARGV[1])
+redis.call('HSET', KEYS[2], 'user_id', ARGV[4], 'session_id', ARGV[5], 'used', 0)
+redis.call('EXPIRE', KEYS[2], ARGV[1])
+redis.call('EXPIRE', KEYS[3], ARGV[1])
+return 1
+`)
+
+// RefreshToken 刷新令牌轮换：旧令牌作废并签发新令牌对；旧令牌被重放时吊销整个会话（令牌族）
+func (s *userService) RefreshToken(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	if refreshToken == "" {
+		return nil, ErrInvalidRefreshToken
+	}
+	res, err := consumeRefreshScript.Run(ctx, s.redisClient, []string{refreshTokenKey(refreshToken)}).Slice()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, errs.Wrap(err, "failed to consume refresh token")
+	}
+	used, _ := res[0].(int64)
+	userIDStr, _ := res[1].(string)
+	sessionID, _ := res[2].(string)
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidRefreshToken
+	}
+	if used > 1 {
+		// 已轮换过的令牌再次出现，说明令牌可能被盗用
+		if err := s.revokeSession(ctx, userID, sessionID); err != nil {
+			return nil, err
+		}
+		return nil, ErrRefreshTokenReused
+	}
+	session, err := s.getSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.UserID != userID {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to get user")
+	}
+	if user.Status != model.UserStatusNormal {
+		return nil, ErrUserDisabled
+	}
+	return s.issueTokens(ctx, user, sessionID)
+}
+
+// issueTokens 在会话下签发令牌对，并顺延会话有效期（滑动会话）
+func (s *userService) issueTokens(ctx context.Context, user *model.User, sessionID string) (*TokenPair, error) {
+	accessToken, claims, err := jwt.GenerateToken(user.ID, user.Username, sessionID, s.accessExpire)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to generate token")
+	}
+	refreshToken, err := utils.GenerateRandomString(refreshTokenLength)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to generate refresh token")
+	}
+
+	// 会话只认最新签发的访问令牌，轮换后旧访问令牌立即失效；
+	// 刷新期间会话被吊销时不再写入，避免重新创建会话
+	saved, err := saveTokensScript.Run(ctx, s.redisClient,
+		[]string{sessionKey(sessionID), refreshTokenKey(refreshToken), userSessionsKey(user.ID)},
+		int64(s.refreshExpire/time.Second), claims.ID, time.Now().Unix(), user.ID, sessionID,
+	).Int()
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to save refresh token")
+	}
+	if saved == 0 {
+		return nil, ErrSessionExpired
+	}
+	return &TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(s.accessExpire / time.Second),
+	}, nil
+}
+
+// refreshTokenKey Redis 中只保存刷新令牌的哈希
+func refreshTokenKey(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+	return "refresh:token:" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+	commonPb "live-stream-platform/gen/proto/common"
+	"live-stream-platform/pkg/errs"
+	"live-stream-platform/pkg/logger"
+	"live-stream-platform/services/user-service/internal/model"
+)
+
+const (
+	// userCacheTTL 用户资料缓存基础有效期
+	userCacheTTL = 30 * time.Minute
+	// userCacheJitter 有效期随机抖动上限，避免大批缓存同时过期
+	userCacheJitter = 5 * time.Minute
+	// userNegativeCacheTTL 不存在的用户的缓存有效期，防止缓存穿透
+	userNegativeCacheTTL = time.Minute
+	// userNotFoundMarker 负缓存标记；有效的 UserInfo 至少带 id，序列化结果不会为空
+	userNotFoundMarker = ""
+)
+
+// getUserInfo 读取用户资料（不含关注计数）：先查缓存，未命中时合并并发请求回源数据库。
+// 返回值是调用方独享的副本，可以直接修改
+func (s *userService) getUserInfo(ctx context.Context, userID int64) (*commonPb.UserInfo, error) {
+	key := userCacheKey(userID)
+	cached, err := s.redisClient.Get(ctx, key).Result()
+	if err == nil {
+		return decodeUserInfo(cached)
+	}
+	if !errors.Is(err, redis.Nil) {
+		logger.Warn(ctx, "Failed to get user cache", logger.Err(err))
+	}
+
+	v, err, _ := s.userGroup.Do(key, func() (any, error) {
+		// 回源结果由所有等待者共享，不受首个调用方取消的影响
+		ctx := context.WithoutCancel(ctx)
+		user, err := s.userRepo.GetByID(ctx, userID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				s.setUserCache(ctx, key, userNotFoundMarker, userNegativeCacheTTL)
+				return nil, ErrUserNotFound
+			}
+			return nil, errs.Wrap(err, "failed to get user")
+		}
+		userInfo := toUserInfo(user)
+		if data, err := proto.Marshal(userInfo); err == nil {
+			s.setUserCache(ctx, key, string(data), userCacheExpiration())
+		}
+		return userInfo, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// singleflight 的结果被多个调用方共享，返回副本
+	return proto.Clone(v.(*commonPb.UserInfo)).(*commonPb.UserInfo), nil
+}
+
+// getUserInfos 批量读取用户资料：MGET 命中的直接返回，只对未命中的 ID 查询数据库。
+// 结果按 userIDs 的顺序排列，重复和不存在的 ID 会被忽略
+func (s *userService) getUserInfos(ctx context.Context, userIDs []int64) ([]*commonPb.UserInfo, error) {
+	ids := make([]int64, 0, len(userIDs))
+	seen := make(map[int64]bool, len(userIDs))
+	for _, id := range userIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = userCacheKey(id)
+	}
+
+	found := make(map[int64]*commonPb.UserInfo, len(ids))
+	misses := ids
+	values, err := s.redisClient.MGet(ctx, keys...).Result()
+	if err != nil {
+		logger.Warn(ctx, "Failed to get user cache", logger.Err(err))
+	} else {
+		misses = make([]int64, 0)
+		for i, value := range values {
+			cached, ok := value.(string)
+			if !ok {
+				misses = append(misses, ids[i])
+				continue
+			}
+			userInfo, err := decodeUserInfo(cached)
+			if err == nil {
+				found[ids[i]] = userInfo
+			} else if !errors.Is(err, ErrUserNotFound) {
+				misses = append(misses, ids[i])
+			}
+		}
+	}
+
+	if len(misses) > 0 {
+		users, err := s.userRepo.GetByIDs(ctx, misses)
+		if err != nil {
+			return nil, errs.Wrap(err, "failed to get users")
+		}
+		s.cacheUsers(ctx, misses, users)
+		for _, user := range users {
+			found[user.ID] = toUserInfo(user)
+		}
+	}
+
+	userInfos := make([]*commonPb.UserInfo, 0, len(found))
+	for _, id := range ids {
+		if userInfo, ok := found[id]; ok {
+			userInfos = append(userInfos, userInfo)
+		}
+	}
+	return userInfos, nil
+}
+
+// cacheUsers 回写批量查询的结果，数据库中不存在的 ID 写入负缓存
+func (s *userService) cacheUsers(ctx context.Context, ids []int64, users []*model.User) {
+	pipe := s.redisClient.Pipeline()
+	loaded := make(map[int64]bool, len(users))
+	for _, user := range users {
+		loaded[user.ID] = true
+		data, err := proto.Marshal(toUserInfo(user))
+		if err != nil {
+			continue
+		}
+		pipe.Set(ctx, userCacheKey(user.ID), data, userCacheExpiration())
+	}
+	for _, id := range ids {
+		if !loaded[id] {
+			pipe.Set(ctx, userCacheKey(id), userNotFoundMarker, userNegativeCacheTTL)
+		}
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		logger.Warn(ctx, "Failed to cache users", logger.Err(err))
+	}
+}
+
+func (s *userService) setUserCache(ctx context.Context, key, value string, ttl time.Duration) {
+	if err := s.redisClient.Set(ctx, key, value, ttl).Err(); err != nil {
+		logger.Warn(ctx, "Failed to cache user", logger.Err(err))
+	}
+}
+
+// invalidateUserCache 删除用户资料缓存
+func (s *userService) invalidateUserCache(ctx context.Context, userID int64) {
+	if err := s.redisClient.Del(ctx, userCacheKey(userID)).Err(); err != nil {
+		logger.Warn(ctx, "Failed to invalidate user cache", logger.Err(err))
+	}
+}
+
+func decodeUserInfo(cached string) (*commonPb.UserInfo, error) {
+	if cached == userNotFoundMarker {
+		return nil, ErrUserNotFound
+	}
+	userInfo := &commonPb.UserInfo{}
+	if err := proto.Unmarshal([]byte(cached), userInfo); err != nil {
+		return nil, errs.Wrap(err, "failed to decode user cache")
+	}
+	return userInfo, nil
+}
+
+func userCacheExpiration() time.Duration {
+	return userCacheTTL + time.Duration(rand.Int64N(int64(userCacheJitter)))
+}
+
+func userCacheKey(userID int64) string {
+	return "user:info:" + strconv.FormatInt(userID, 10)
+}
@@ -3,16 +3,19 @@ package service
 import (
 	"context"
 	"errors"
-	"fmt"
 	"live-stream-platform/services/user-service/internal/model"
 	"live-stream-platform/services/user-service/internal/repository"
 	"time"
 
 	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
 	"gorm.io/gorm"
 	commonPb "live-stream-platform/gen/proto/common"
 	userPb "live-stream-platform/gen/proto/user"
+	"live-stream-platform/pkg/database"
+	"live-stream-platform/pkg/errs"
 	"live-stream-platform/pkg/jwt"
+	"live-stream-platform/pkg/metrics"
 	"live-stream-platform/pkg/utils"
 )
 
@@ -21,7 +24,9 @@ type UserService interface {
 	//Register 用户注册
 	Register(ctx context.Context, req *userPb.RegisterRequest) (int64, error)
 	// Login 用户登录
-	Login(ctx context.Context, req *userPb.LoginRequest) (string, *commonPb.UserInfo, error)
+	Login(ctx context.Context, req *userPb.LoginRequest) (*TokenPair, *commonPb.UserInfo, error)
+	// RefreshToken 使用刷新令牌换取新的令牌对
+	RefreshToken(ctx context.Context, refreshToken string) (*TokenPair, error)
 	// Logout 用户登出
 	Logout(ctx context.Context, userID int64, token string) error
 	// GetUserInfo 获取用户信息
@@ -32,50 +37,99 @@ type UserService interface {
 	VerifyToken(ctx context.Context, token string) (*jwt.Claims, error)
 	// GetUsersByIds 批量获取用户信息
 	GetUsersByIds(ctx context.Context, userIDs []int64) ([]*commonPb.UserInfo, error)
+	// Follow 关注用户
+	Follow(ctx context.Context, userID, targetUserID int64) error
+	// Unfollow 取消关注
+	Unfollow(ctx context.Context, userID, targetUserID int64) error
+	// IsFollowing 批量查询是否已关注
+	IsFollowing(ctx context.Context, userID int64, targetUserIDs []int64) (map[int64]bool, error)
+	// ListFollowers 查询粉丝列表
+	ListFollowers(ctx context.Context, userID, cursor int64, limit int32) ([]*userPb.FollowItem, int64, error)
+	// ListFollowing 查询关注列表
+	ListFollowing(ctx context.Context, userID, cursor int64, limit int32) ([]*userPb.FollowItem, int64, error)
+	// ListSessions 查询用户的登录会话
+	ListSessions(ctx context.Context, userID int64) ([]*userPb.SessionInfo, error)
+	// RevokeSession 吊销指定会话
+	RevokeSession(ctx context.Context, userID int64, sessionID string) error
+	// RevokeAllSessions 吊销用户的所有会话，可保留一个会话
+	RevokeAllSessions(ctx context.Context, userID int64, exceptSessionID string) error
+	// UpdateUserStatus 更新用户状态，禁用时吊销该用户所有 Token
+	UpdateUserStatus(ctx context.Context, userID int64, status int32) (*commonPb.UserInfo, error)
+	// ListUsers 按条件分页查询用户
+	ListUsers(ctx context.Context, req *userPb.ListUsersRequest) ([]*commonPb.UserInfo, *commonPb.PageResponse, error)
+	// SendVerificationEmail 重新发送邮箱验证邮件
+	SendVerificationEmail(ctx context.Context, userID int64) error
+	// VerifyEmail 使用邮件中的令牌验证邮箱
+	VerifyEmail(ctx context.Context, token string) error
+	// ForgotPassword 发送重置密码邮件
+	ForgotPassword(ctx context.Context, email string) error
+	// ResetPassword 使用邮件中的令牌重置密码并吊销所有会话
+	ResetPassword(ctx context.Context, token, newPassword string) error
+	// OnUserRegistered 处理注册事件，发送验证邮件
+	OnUserRegistered(ctx context.Context, event *userPb.UserRegistered) error
 }
 
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
 // userService 用户服务实现
 type userService struct {
-	userRepo    repository.UserRepository
-	redisClient *redis.Client
-	jwtExpire   int
+	userRepo      repository.UserRepository
+	followRepo    repository.FollowRepository
+	redisClient   *redis.Client
+	accessExpire  time.Duration
+	refreshExpire time.Duration
+	email         EmailOptions
+	// userGroup 合并同一用户并发的缓存未命中
+	userGroup singleflight.Group
+	// eventSlots 限制后台发布中的事件数，broker 不可用时不会无限堆积 goroutine
+	eventSlots chan struct{}
+	// resetSlots 限制后台发送中的重置密码邮件数，邮件服务变慢时不会无限堆积 goroutine
+	resetSlots chan struct{}
 }
 
-func NewUserService(userRepo repository.UserRepository, redisClient *redis.Client, jwtExpire int) UserService {
+func NewUserService(userRepo repository.UserRepository, followRepo repository.FollowRepository, redisClient *redis.Client, accessExpire, refreshExpire time.Duration, email EmailOptions) UserService {
 	return &userService{
-		userRepo:    userRepo,
-		redisClient: redisClient,
-		jwtExpire:   jwtExpire,
+		userRepo:      userRepo,
+		followRepo:    followRepo,
+		redisClient:   redisClient,
+		accessExpire:  accessExpire,
+		refreshExpire: refreshExpire,
+		email:         email,
+		eventSlots:    make(chan struct{}, maxPendingEvents),
+		resetSlots:    make(chan struct{}, maxPendingResets),
 	}
 }
 
 // Register 用户注册
 func (s *userService) Register(ctx context.Context, req *userPb.RegisterRequest) (int64, error) {
 	if !utils.ValidateEmail(req.Email) {
-		return 0, errors.New("invalid email")
+		return 0, invalidParam("email", "invalid email")
 	}
 	if !utils.ValidateUsername(req.Username) {
-		return 0, errors.New("invalid username:3-20 characters, alphanumeric and underscore only")
+		return 0, invalidParam("username", "invalid username: 3-20 characters, alphanumeric and underscore only")
 	}
 	if !utils.ValidatePassword(req.Password) {
-		return 0, errors.New("invalid password: at least 8 characters with uppercase, lowercase and number")
+		return 0, invalidParam("password", "invalid password: at least 8 characters with uppercase, lowercase and number")
 	}
 
 	if _, err := s.userRepo.GetByEmail(ctx, req.Email); err == nil {
-		return 0, errors.New("email already exists")
+		return 0, ErrEmailExists
 	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
-		return 0, fmt.Errorf("failed to check email: %w", err)
+		return 0, errs.Wrap(err, "failed to check email")
 	}
 
 	if _, err := s.userRepo.GetByUsername(ctx, req.Username); err == nil {
-		return 0, errors.New("username already exists")
+		return 0, ErrUsernameExists
 	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
-		return 0, fmt.Errorf("failed to check username: %w", err)
+		return 0, errs.Wrap(err, "failed to check username")
 	}
 
 	passwordHash, err := utils.HashPassword(req.Password)
 	if err != nil {
-		return 0, fmt.Errorf("failed to hash password: %w", err)
+		return 0, errs.Wrap(err, "failed to hash password")
 	}
 	user := &model.User{
 		Email:        req.Email,
@@ -83,111 +137,108 @@ func (s *userService) Register(ctx context.Context, req *userPb.RegisterRequest)
 		PasswordHash: passwordHash,
 		Nickname:     req.Nickname,
 		Gender:       int(req.Gender),
-		Status:       1,
+		Status:       model.UserStatusNormal,
 	}
-	if err := s.userRepo.Create(ctx, user); err != nil {
-		return 0, fmt.Errorf("failed to create user: %w", err)
+	// 用户与注册事件在同一事务中写入，事件由发件箱投递
+	err = s.userRepo.Transaction(ctx, func(repo repository.UserRepository) error {
+		if err := repo.Create(ctx, user); err != nil {
+			return err
+		}
+		return repo.EnqueueEvent(ctx, &userPb.UserRegistered{
+			UserId:   user.ID,
+			Username: user.Username,
+			Email:    user.Email,
+			Nickname: user.Nickname,
+		})
+	})
+	if err != nil {
+		return 0, errs.Wrap(err, "failed to create user")
 	}
+	// 清理可能存在的负缓存
+	s.invalidateUserCache(ctx, user.ID)
+	metrics.UserRegistrations.Inc()
 	return user.ID, nil
 }
 
 // Login 用户登录
-func (s *userService) Login(ctx context.Context, req *userPb.LoginRequest) (string, *commonPb.UserInfo, error) {
+func (s *userService) Login(ctx context.Context, req *userPb.LoginRequest) (*TokenPair, *commonPb.UserInfo, error) {
+	// 读主库：刚注册的用户在从库上可能还查不到
+	ctx = database.WithPrimary(ctx)
 	user, err := s.userRepo.GetByUsername(ctx, req.Username)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return "", nil, errors.New("username or password incorrect")
+			metrics.UserLogins.WithLabelValues("failure").Inc()
+			return nil, nil, ErrInvalidCredentials
 		}
-		return "", nil, fmt.Errorf("failed to get user: %w", err)
+		return nil, nil, errs.Wrap(err, "failed to get user")
 	}
-	if user.Status != 1 {
-		return "", nil, errors.New("user account is disabled")
+	if user.Status != model.UserStatusNormal {
+		metrics.UserLogins.WithLabelValues("failure").Inc()
+		return nil, nil, ErrUserDisabled
 	}
 	if !utils.CheckPassword(req.Password, user.PasswordHash) {
-		return "", nil, errors.New("username or password incorrect")
+		metrics.UserLogins.WithLabelValues("failure").Inc()
+		return nil, nil, ErrInvalidCredentials
 	}
 
-	token, err := jwt.GenerateToken(user.ID, user.Username, s.jwtExpire)
+	sessionID, err := s.createSession(ctx, user.ID, req)
 	if err != nil {
-		return "", nil, fmt.Errorf("failed to generate token: %w", err)
+		return nil, nil, err
 	}
-	tokenKey := fmt.Sprintf("token:%s", token)
-	if err := s.redisClient.Set(ctx, tokenKey, user.ID, time.Duration(s.jwtExpire)*time.Hour).Err(); err != nil {
-		fmt.Printf("Warning: Failed to cache token: %v\n", err)
+	tokens, err := s.issueTokens(ctx, user, sessionID)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	userInfo := &commonPb.UserInfo{
-		Id:        user.ID,
-		Username:  user.Username,
-		Nickname:  user.Nickname,
-		Email:     user.Email,
-		Gender:    int32(user.Gender),
-		Avatar:    user.Avatar,
-		Status:    int32(user.Status),
-		CreatedAt: user.CreatedAt.Unix(),
-	}
-	return token, userInfo, nil
+	metrics.UserLogins.WithLabelValues("success").Inc()
+	s.publishEvent(ctx, &userPb.UserLoggedIn{
+		UserId:     user.ID,
+		SessionId:  sessionID,
+		Ip:         req.Ip,
+		DeviceName: req.DeviceName,
+		UserAgent:  req.UserAgent,
+	})
+	userInfo := toUserInfo(user)
+	return tokens, userInfo, nil
 }
 
 // Logout 用户登出
 func (s *userService) Logout(ctx context.Context, userID int64, token string) error {
-	// 删除 Redis 中的 Token
-	tokenKey := fmt.Sprintf("token:%s", token)
-	if err := s.redisClient.Del(ctx, tokenKey).Err(); err != nil {
-		fmt.Printf("Warning: Failed to cache token: %v\n", err)
+	// 校验 Token 属于当前用户，防止注销他人的 Token
+	claims, err := jwt.ParseToken(token)
+	if err != nil {
+		return ErrInvalidToken
 	}
-	// 将 token 加入黑名单
-	blacklistKey := fmt.Sprintf("token:blacklist:%s", token)
-	if err := s.redisClient.Set(ctx, blacklistKey, userID, time.Duration(s.jwtExpire)*time.Hour).Err(); err != nil {
-		fmt.Printf("Warning: Failed to cache blacklist: %v\n", err)
+	if claims.UserID != userID {
+		return ErrTokenNotOwned
 	}
-	return nil
+	// 吊销当前会话，会话下的访问令牌与刷新令牌同时失效
+	return s.revokeSession(ctx, userID, claims.SessionID)
 }
 
 // GetUserInfo 获取用户信息
 func (s *userService) GetUserInfo(ctx context.Context, userID int64) (*commonPb.UserInfo, error) {
-	// 1. 尝试从 Redis 获取缓存
-	// TODO 实现缓存
-	//cacheKey := fmt.Sprintf("user:info:%d", userID)
-	//res, err := s.redisClient.Get(ctx, cacheKey).Result()
-	//if err == nil {
-	//
-	//}
-	// TODO 这里简化了缓存实现，实际应该序列化后存储
-	// 暂时直接从数据库中获取
-	//2.从数据库中获取
-	user, err := s.userRepo.GetByID(ctx, userID)
+	// 1. 读取资料（缓存 -> 数据库）
+	userInfo, err := s.getUserInfo(ctx, userID)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("user not found")
-		}
-		return nil, fmt.Errorf("failed to get user: %w", err)
-	}
-	// 3. 转换成 protobuf 消息
-	userInfo := &commonPb.UserInfo{
-		Id:        user.ID,
-		Username:  user.Username,
-		Nickname:  user.Nickname,
-		Email:     user.Email,
-		Gender:    int32(user.Gender),
-		Avatar:    user.Avatar,
-		Status:    int32(user.Status),
-		CreatedAt: user.CreatedAt.Unix(),
-	}
-	// 4. 写入缓存 (简化版)
-	//TODO 实现完整的缓存逻辑
+		return nil, err
+	}
+	// 2. 关注计数单独缓存，不随资料缓存过期
+	s.fillFollowCounts(ctx, []*commonPb.UserInfo{userInfo})
 	return userInfo, nil
 }
 
 // UpdateUserInfo 更新用户信息
 func (s *userService) UpdateUserInfo(ctx context.Context, req *userPb.UpdateUserInfoRequest) error {
+	// 读-改-写，且随后要回填缓存，都读主库
+	ctx = database.WithPrimary(ctx)
 	// 1. 获取用户
 	user, err := s.userRepo.GetByID(ctx, req.UserId)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errors.New("user not found")
+			return ErrUserNotFound
 		}
-		return fmt.Errorf("failed to get user: %w", err)
+		return errs.Wrap(err, "failed to get user")
 	}
 	// 2. 更新字段
 	if req.Nickname != "" {
@@ -199,29 +250,38 @@ func (s *userService) UpdateUserInfo(ctx context.Context, req *userPb.UpdateUser
 	if req.Avatar != "" {
 		user.Avatar = req.Avatar
 	}
-	// 3. 保存更新
-	if err = s.userRepo.Update(ctx, user); err != nil {
-		return fmt.Errorf("failed to update user: %w", err)
+	// 3. 保存更新，并写入资料变更事件
+	err = s.userRepo.Transaction(ctx, func(repo repository.UserRepository) error {
+		if err := repo.Update(ctx, user); err != nil {
+			return err
+		}
+		return repo.EnqueueEvent(ctx, userUpdatedEvent(user))
+	})
+	if err != nil {
+		return errs.Wrap(err, "failed to update user")
 	}
-	// 4. 删除缓存
-	cacheKey := fmt.Sprintf("user:info:%d", user.ID)
-	s.redisClient.Del(ctx, cacheKey)
+	// 4. 用更新后的数据回填缓存：只删除的话，随后的读请求可能回源到尚未同步的从库，把旧资料写回缓存
+	s.cacheUsers(ctx, []int64{user.ID}, []*model.User{user})
 	return nil
 }
 
 // VerifyToken 验证 Token
 func (s *userService) VerifyToken(ctx context.Context, token string) (*jwt.Claims, error) {
-	// 1. 检查 token 是否在黑名单（已登出）
-	blacklistKey := fmt.Sprintf("token:blacklist:%s", token)
-	exists, err := s.redisClient.Exists(ctx, blacklistKey).Result()
-	if err == nil && exists > 0 {
-		return nil, errors.New("token has been revoked")
-	}
-	// 2. 解析和验证 token
+	// 1. 解析和验证 token
 	claims, err := jwt.ParseToken(token)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse token: %w", err)
+		return nil, ErrInvalidToken
+	}
+	// 2. 检查会话是否有效（登出、踢下线、封禁或刷新令牌重放都会删除会话）
+	session, err := s.getSession(ctx, claims.SessionID)
+	if err != nil {
+		return nil, err
 	}
+	// 3. 会话只认最新签发的访问令牌
+	if session.UserID != claims.UserID || session.JTI != claims.ID {
+		return nil, ErrTokenRevoked
+	}
+	s.touchSession(ctx, session)
 	return claims, nil
 }
 
@@ -229,25 +289,106 @@ func (s *userService) GetUsersByIds(ctx context.Context, userIDs []int64) ([]*co
 	if len(userIDs) == 0 {
 		return []*commonPb.UserInfo{}, nil
 	}
-	// 1. 从数据库批量查询
-	users, err := s.userRepo.GetByIDs(ctx, userIDs)
+	// 1. 批量读取资料，只有缓存未命中的 ID 会查询数据库
+	userInfos, err := s.getUserInfos(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	// 2. 填充关注计数
+	s.fillFollowCounts(ctx, userInfos)
+	return userInfos, nil
+}
+
+// UpdateUserStatus 更新用户状态
+func (s *userService) UpdateUserStatus(ctx context.Context, userID int64, status int32) (*commonPb.UserInfo, error) {
+	if status != model.UserStatusDisabled && status != model.UserStatusNormal {
+		return nil, invalidParam("status", "invalid status")
+	}
+	ctx = database.WithPrimary(ctx)
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, errs.Wrap(err, "failed to get user")
+	}
+	// 状态未变化时不重复写库和发事件，调用方（如 admin-service）失败后可以安全重试
+	if user.Status != int(status) {
+		user.Status = int(status)
+		err = s.userRepo.Transaction(ctx, func(repo repository.UserRepository) error {
+			if err := repo.UpdateStatus(ctx, userID, int(status)); err != nil {
+				return err
+			}
+			if status == model.UserStatusDisabled {
+				return repo.EnqueueEvent(ctx, &userPb.UserBanned{UserId: userID})
+			}
+			return repo.EnqueueEvent(ctx, userUpdatedEvent(user))
+		})
+		if err != nil {
+			return nil, errs.Wrap(err, "failed to update status")
+		}
+	}
+	// 禁用时总是吊销会话，重试期间新建的会话也会被清除
+	if status == model.UserStatusDisabled {
+		if err := s.RevokeAllSessions(ctx, userID, ""); err != nil {
+			return nil, err
+		}
+	}
+	s.cacheUsers(ctx, []int64{userID}, []*model.User{user})
+	return toUserInfo(user), nil
+}
+
+// ListUsers 按条件分页查询用户
+func (s *userService) ListUsers(ctx context.Context, req *userPb.ListUsersRequest) ([]*commonPb.UserInfo, *commonPb.PageResponse, error) {
+	pageNum, pageSize := int32(1), int32(defaultPageSize)
+	if req.Page != nil {
+		if req.Page.Page > 0 {
+			pageNum = req.Page.Page
+		}
+		if req.Page.PageSize > 0 && req.Page.PageSize <= maxPageSize {
+			pageSize = req.Page.PageSize
+		}
+	}
+	filter := &repository.UserFilter{Keyword: req.Keyword}
+	if req.Status != nil {
+		status := int(*req.Status)
+		filter.Status = &status
+	}
+	if req.Created != nil {
+		if req.Created.StartTime > 0 {
+			start := time.Unix(req.Created.StartTime, 0)
+			filter.CreatedAfter = &start
+		}
+		if req.Created.EndTime > 0 {
+			end := time.Unix(req.Created.EndTime, 0)
+			filter.CreatedBefore = &end
+		}
+	}
+	users, total, err := s.userRepo.List(ctx, filter, int((pageNum-1)*pageSize), int(pageSize))
 	if err != nil {
-		return nil, fmt.Errorf("failed to get users: %w", err)
+		return nil, nil, errs.Wrap(err, "failed to list users")
 	}
-	//2. 转换为 protobuf 消息
 	userInfos := make([]*commonPb.UserInfo, 0, len(users))
 	for _, user := range users {
-		userInfo := &commonPb.UserInfo{
-			Id:        user.ID,
-			Username:  user.Username,
-			Nickname:  user.Nickname,
-			Email:     user.Email,
-			Gender:    int32(user.Gender),
-			Avatar:    user.Avatar,
-			Status:    int32(user.Status),
-			CreatedAt: user.CreatedAt.Unix(),
-		}
-		userInfos = append(userInfos, userInfo)
+		userInfos = append(userInfos, toUserInfo(user))
+	}
+	return userInfos, &commonPb.PageResponse{
+		Page:     pageNum,
+		PageSize: pageSize,
+		Total:    total,
+	}, nil
+}
+
+func toUserInfo(user *model.User) *commonPb.UserInfo {
+	return &commonPb.UserInfo{
+		Id:            user.ID,
+		Username:      user.Username,
+		Nickname:      user.Nickname,
+		Email:         user.Email,
+		Gender:        int32(user.Gender),
+		Avatar:        user.Avatar,
+		Status:        int32(user.Status),
+		CreatedAt:     user.CreatedAt.Unix(),
+		EmailVerified: user.EmailVerified,
 	}
-	return userInfos, nil
 }
@@ -0,0 +1,16 @@
+CREATE TABLE users (
+    id bigint NOT NULL AUTO_INCREMENT,
+    username varchar(50) NOT NULL,
+    email varchar(100) NOT NULL,
+    password_hash varchar(255) NOT NULL,
+    nickname varchar(50) NOT NULL,
+    gender tinyint DEFAULT 0 COMMENT '0-未知 1-男性 2-女性',
+    avatar varchar(255) DEFAULT NULL,
+    status tinyint DEFAULT 1 COMMENT '0-禁用 1-正常',
+    created_at datetime(3) DEFAULT NULL,
+    updated_at datetime(3) DEFAULT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY idx_users_username (username),
+    UNIQUE KEY idx_users_email (email),
+    KEY idx_users_status (status)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,9 @@
+CREATE TABLE follows (
+    id bigint NOT NULL AUTO_INCREMENT,
+    follower_id bigint NOT NULL,
+    followee_id bigint NOT NULL,
+    created_at datetime(3) DEFAULT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_follower_followee (follower_id, followee_id),
+    KEY idx_followee (followee_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,17 @@
+CREATE TABLE outbox_messages (
+    id bigint NOT NULL AUTO_INCREMENT,
+    event_id varchar(64) NOT NULL,
+    routing_key varchar(128) NOT NULL,
+    content_type varchar(64) NOT NULL,
+    producer varchar(64) NOT NULL,
+    body mediumblob NOT NULL,
+    trace_context varchar(1024) NOT NULL DEFAULT '',
+    attempts int NOT NULL DEFAULT 0,
+    last_error varchar(512) NOT NULL DEFAULT '',
+    next_attempt_at datetime(3) NOT NULL,
+    sent_at datetime(3) DEFAULT NULL,
+    created_at datetime(3) NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_event_id (event_id),
+    KEY idx_pending (sent_at, next_attempt_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,6 @@
+CREATE TABLE processed_events (
+    consumer varchar(64) NOT NULL,
+    event_id varchar(64) NOT NULL,
+    processed_at datetime(3) NOT NULL,
+    PRIMARY KEY (consumer, event_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN email_verified tinyint(1) NOT NULL DEFAULT 0 AFTER email;